@@ -0,0 +1,268 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+const analysisRankLimit = 15
+
+// unblockRank describes how much work finishing one issue would release.
+type unblockRank struct {
+	task       models.Task
+	direct     int
+	transitive int
+}
+
+// planningAnalysis summarizes the blocking graph of all open and in-progress work.
+type planningAnalysis struct {
+	criticalPath []models.Task
+	ranks        []unblockRank
+	activeCount  int
+}
+
+// analyzeBlockingGraph computes the longest blocking chain and ranks open
+// issues by how many issues they unblock, directly and transitively.
+func analyzeBlockingGraph(tasks []models.Task) planningAnalysis {
+	active := make(map[string]models.Task)
+	for _, task := range tasks {
+		if task.Status == "open" || task.Status == "in_progress" {
+			active[task.ID] = task
+		}
+	}
+
+	// unblocks[x] lists the active issues that x blocks
+	unblocks := make(map[string][]string, len(active))
+	addEdge := func(blocker, blocked string) {
+		if _, ok := active[blocker]; !ok {
+			return
+		}
+		if _, ok := active[blocked]; !ok || blocker == blocked {
+			return
+		}
+		if !containsString(unblocks[blocker], blocked) {
+			unblocks[blocker] = append(unblocks[blocker], blocked)
+		}
+	}
+	for id, task := range active {
+		for _, blocker := range task.BlockedBy {
+			addEdge(blocker, id)
+		}
+		for _, blocked := range task.Blocks {
+			addEdge(id, blocked)
+		}
+	}
+
+	ids := make([]string, 0, len(active))
+	for id := range active {
+		ids = append(ids, id)
+		sort.Strings(unblocks[id])
+	}
+	sort.Strings(ids)
+
+	// Longest chain over the graph with each cycle condensed into one step:
+	// the issues of a cycle can only be finished together, so they all count
+	// and are listed in ID order. Components come after the ones they reach,
+	// and ties go to the lowest ID, so the result doesn't depend on the input
+	// order.
+	components := stronglyConnected(ids, unblocks)
+	componentOf := make(map[string]int, len(ids))
+	for c, members := range components {
+		sort.Strings(members)
+		for _, id := range members {
+			componentOf[id] = c
+		}
+	}
+	chains := make([][]string, len(components))
+	for c, members := range components {
+		var longest []string
+		for _, id := range members {
+			for _, next := range unblocks[id] {
+				n := componentOf[next]
+				if n == c {
+					continue
+				}
+				if chain := chains[n]; len(chain) > len(longest) || (len(chain) == len(longest) && chain[0] < longest[0]) {
+					longest = chain
+				}
+			}
+		}
+		chains[c] = append(append([]string{}, members...), longest...)
+	}
+
+	var critical []string
+	for _, chain := range chains {
+		if len(chain) > len(critical) || (len(chain) == len(critical) && chain[0] < critical[0]) {
+			critical = chain
+		}
+	}
+
+	analysis := planningAnalysis{activeCount: len(active)}
+	if len(critical) > 1 {
+		for _, id := range critical {
+			analysis.criticalPath = append(analysis.criticalPath, active[id])
+		}
+	}
+
+	for _, id := range ids {
+		task := active[id]
+		if task.Status != "open" || len(unblocks[id]) == 0 {
+			continue
+		}
+		reached := make(map[string]bool)
+		queue := append([]string{}, unblocks[id]...)
+		for len(queue) > 0 {
+			next := queue[0]
+			queue = queue[1:]
+			if reached[next] || next == id {
+				continue
+			}
+			reached[next] = true
+			queue = append(queue, unblocks[next]...)
+		}
+		analysis.ranks = append(analysis.ranks, unblockRank{
+			task:       task,
+			direct:     len(unblocks[id]),
+			transitive: len(reached),
+		})
+	}
+
+	sort.SliceStable(analysis.ranks, func(i, j int) bool {
+		a, b := analysis.ranks[i], analysis.ranks[j]
+		if a.transitive != b.transitive {
+			return a.transitive > b.transitive
+		}
+		if a.direct != b.direct {
+			return a.direct > b.direct
+		}
+		return a.task.Priority < b.task.Priority
+	})
+
+	return analysis
+}
+
+// openAnalysis renders the planning analysis into its scrollable overlay.
+func (m *Model) openAnalysis() {
+	m.updateAnalysisSize()
+	m.analysis.SetContent(renderPlanningAnalysis(analyzeBlockingGraph(m.tasks), m.analysis.Width))
+	m.analysis.GotoTop()
+	m.mode = ViewAnalysis
+}
+
+func renderPlanningAnalysis(a planningAnalysis, width int) string {
+	var b strings.Builder
+	muted := lipgloss.NewStyle().Foreground(ui.ColorMuted)
+	line := func(task models.Task, prefix string) string {
+		text := fmt.Sprintf("%s%s %s %s",
+			prefix,
+			ui.PriorityStyle(task.Priority).Render(task.PriorityString()),
+			muted.Render(task.ID),
+			task.Title)
+		if task.IsBlocked() {
+			text += muted.Render(" (blocked)")
+		} else if task.Status == "in_progress" {
+			text += ui.StatusStyle(task.Status).Render(" (in progress)")
+		}
+		return lipgloss.NewStyle().MaxWidth(width).Render(text)
+	}
+
+	b.WriteString(ui.DetailLabelStyle.UnsetWidth().Render(fmt.Sprintf("Critical path (%d issues)", len(a.criticalPath))))
+	b.WriteString("\n")
+	if len(a.criticalPath) == 0 {
+		b.WriteString(muted.Render("  No blocking chains among open work"))
+		b.WriteString("\n")
+	}
+	for i, task := range a.criticalPath {
+		if i > 0 {
+			b.WriteString(muted.Render("     " + ui.Glyphs.DownArrow + " unblocks"))
+			b.WriteString("\n")
+		}
+		b.WriteString(line(task, fmt.Sprintf("  %2d. ", i+1)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(ui.DetailLabelStyle.UnsetWidth().Render("Unblocks the most"))
+	b.WriteString("\n")
+	if len(a.ranks) == 0 {
+		b.WriteString(muted.Render("  No open issue blocks other work"))
+		b.WriteString("\n")
+	} else {
+		b.WriteString(muted.Render("  direct total"))
+		b.WriteString("\n")
+	}
+	for i, rank := range a.ranks {
+		if i == analysisRankLimit {
+			b.WriteString(muted.Render(fmt.Sprintf("  %s %d more", ui.Glyphs.Ellipsis, len(a.ranks)-analysisRankLimit)))
+			b.WriteString("\n")
+			break
+		}
+		b.WriteString(line(rank.task, fmt.Sprintf("  %6d %5d ", rank.direct, rank.transitive)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(muted.Render(fmt.Sprintf("%d open and in-progress issues analyzed", a.activeCount)))
+	return b.String()
+}
+
+func (m *Model) updateAnalysisSize() {
+	width, height := helpModalSize(m.width, m.height)
+	m.analysis.Width = maxInt(width-4, 1)
+	m.analysis.Height = maxInt(height-4, 1)
+}
+
+func (m *Model) handleAnalysisKeys(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, m.keys.Cancel), key.Matches(msg, m.keys.Analysis), msg.String() == "q":
+		m.mode = ViewList
+	case key.Matches(msg, m.keys.Up):
+		m.analysis.ScrollUp(1)
+	case key.Matches(msg, m.keys.Down):
+		m.analysis.ScrollDown(1)
+	case key.Matches(msg, m.keys.PageUp):
+		m.analysis.HalfPageUp()
+	case key.Matches(msg, m.keys.PageDown):
+		m.analysis.HalfPageDown()
+	case key.Matches(msg, m.keys.Top):
+		m.analysis.GotoTop()
+	case key.Matches(msg, m.keys.Bottom):
+		m.analysis.GotoBottom()
+	}
+	return nil
+}
+
+func (m Model) viewAnalysis() string {
+	width, height := helpModalSize(m.width, m.height)
+
+	var b strings.Builder
+	b.WriteString(ui.TitleStyle.Render("Planning Analysis"))
+	b.WriteString("\n")
+	b.WriteString(m.analysis.View())
+	b.WriteString("\n")
+	helpParts := []string{
+		ui.HelpKeyStyle.Render("j/k") + ":" + ui.HelpDescStyle.Render("scroll"),
+		ui.HelpKeyStyle.Render("^u/^d") + ":" + ui.HelpDescStyle.Render("page"),
+		ui.HelpKeyStyle.Render("q/I/esc") + ":" + ui.HelpDescStyle.Render("close"),
+	}
+	b.WriteString(ui.HelpBarStyle.Render(strings.Join(helpParts, "  ")))
+
+	modal := ui.OverlayStyle.Padding(0, 1).
+		Width(width).
+		Height(height).
+		Render(b.String())
+
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		modal,
+	)
+}
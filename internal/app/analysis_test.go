@@ -0,0 +1,96 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"lazybeads/internal/models"
+)
+
+func TestAnalyzeBlockingGraph(t *testing.T) {
+	open := func(id string, priority int, blockers ...string) models.Task {
+		return models.Task{ID: id, Status: "open", Priority: priority, BlockedBy: blockers}
+	}
+
+	tests := []struct {
+		name     string
+		tasks    []models.Task
+		critical string
+		ranks    string // id direct/transitive, best first
+	}{
+		{
+			name: "dag",
+			tasks: []models.Task{
+				open("a", 2), open("b", 2, "a"), open("c", 2, "b"), open("d", 2, "a"),
+				{ID: "done", Status: "closed", Blocks: []string{"a"}},
+			},
+			critical: "a b c",
+			ranks:    "a 2/3, b 1/1",
+		},
+		{
+			name: "ties go to priority, then ID",
+			tasks: []models.Task{
+				open("x", 2), open("y", 1), open("z", 2),
+				open("x1", 2, "x"), open("y1", 2, "y"), open("z1", 2, "z"),
+			},
+			critical: "x x1",
+			ranks:    "y 1/1, x 1/1, z 1/1",
+		},
+		{
+			name: "in-progress issues are on the path but not ranked",
+			tasks: []models.Task{
+				{ID: "a", Status: "in_progress", Blocks: []string{"b"}}, open("b", 2),
+			},
+			critical: "a b",
+			ranks:    "",
+		},
+		{
+			// A cycle counts as one step holding all its issues, whichever
+			// issue the input lists first
+			name:     "cycle",
+			tasks:    []models.Task{open("a", 2, "c"), open("b", 2, "a"), open("c", 2, "b"), open("d", 2, "b")},
+			critical: "a b c d",
+			ranks:    "b 2/3, a 1/3, c 1/3",
+		},
+		{
+			name: "cycle ties go to the lowest ID",
+			tasks: []models.Task{
+				open("p", 2, "q"), open("q", 2, "p"),
+				open("m", 2), open("n", 2, "m"),
+			},
+			critical: "m n",
+			ranks:    "m 1/1, p 1/1, q 1/1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := analyzeBlockingGraph(tt.tasks)
+
+			// The critical path is the same in any input order
+			var reversed, rotated []models.Task
+			for i := range tt.tasks {
+				reversed = append(reversed, tt.tasks[len(tt.tasks)-1-i])
+				rotated = append(rotated, tt.tasks[(i+1)%len(tt.tasks)])
+			}
+			for _, tasks := range [][]models.Task{tt.tasks, reversed, rotated} {
+				var path []string
+				for _, task := range analyzeBlockingGraph(tasks).criticalPath {
+					path = append(path, task.ID)
+				}
+				if got := strings.Join(path, " "); got != tt.critical {
+					t.Errorf("expected critical path %q, got %q", tt.critical, got)
+				}
+			}
+
+			var ranks []string
+			for _, rank := range a.ranks {
+				ranks = append(ranks, fmt.Sprintf("%s %d/%d", rank.task.ID, rank.direct, rank.transitive))
+			}
+			if got := strings.Join(ranks, ", "); got != tt.ranks {
+				t.Errorf("expected ranks %q, got %q", tt.ranks, got)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package app
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -15,7 +18,9 @@ import (
 
 	"lazybeads/internal/beads"
 	"lazybeads/internal/config"
+	"lazybeads/internal/drafts"
 	"lazybeads/internal/models"
+	"lazybeads/internal/session"
 	"lazybeads/internal/ui"
 )
 
@@ -33,6 +38,18 @@ const (
 	ViewEditPriority
 	ViewEditType
 	ViewFilter
+	ViewCycles
+	ViewAnalysis
+	ViewChanges
+	ViewLinkKind
+	ViewTemplate
+	ViewCapture
+	ViewConflict
+	ViewRestoreDraft
+	ViewChecklist
+	ViewLinkPicker
+	ViewPalette
+	ViewGoto
 )
 
 const (
@@ -43,7 +60,14 @@ const (
 	panelWidthStep     = 5
 )
 
-const formFieldCount = 8
+const (
+	formFieldCount  = 12
+	formSubmitFocus = formFieldCount - 1
+
+	// defaultFormFieldHeight is how many lines a form text field shows before
+	// it scrolls, unless configured
+	defaultFormFieldHeight = 10
+)
 
 type editorField string
 
@@ -84,12 +108,34 @@ func (t taskItem) FilterValue() string {
 // Model is the main application state
 type Model struct {
 	client *beads.Client
-	keys   ui.KeyMap
-	help   help.Model
+
+	// Shared by the copies of the model, so polls reuse fetched children
+	epicChildren *epicChildCache
+
+	// Links of the issue in the detail pane, also shared by the copies
+	linkCache *taskLinkCache
+	keys      ui.KeyMap
+	help      help.Model
+
+	// Bindings of the issue list and detail view, with context overrides
+	listKeys   ui.KeyMap
+	detailKeys ui.KeyMap
 
 	// Data
 	tasks    []models.Task
 	selected *models.Task
+	cycles   []dependencyCycle
+
+	// Changes found by refreshes: the log (newest first), how many arrived
+	// since it was last opened, and when each changed row stops highlighting
+	changeLog     []taskChange
+	unseenChanges int
+	changedUntil  map[string]time.Time
+
+	// The last complete load the next one is compared with, and issues
+	// changed from lazybeads itself, left out of that comparison
+	changeBase []models.Task
+	ownEdits   map[string]time.Time
 
 	// UI state
 	mode         ViewMode
@@ -101,16 +147,30 @@ type Model struct {
 	detailWidth  int
 	panelAdjust  int
 
+	// Mouse state: a border drag in progress and the last clicked issue,
+	// to tell double-clicks
+	resizingPanels bool
+	lastClickID    string
+	lastClickAt    time.Time
+
+	// Epics expanded inline in their panel, keyed by issue ID
+	expandedEpics map[string]bool
+
+	// Show description and design text as written instead of rendered markdown
+	rawMarkdown bool
+
 	// Panels (3 vertically stacked)
 	inProgressPanel PanelModel
 	openPanel       PanelModel
 	closedPanel     PanelModel
 
 	// Components
-	detail     viewport.Model
-	helpList   list.Model
-	filterText textinput.Model
-	helpItems  []helpItem
+	detail      viewport.Model
+	analysis    viewport.Model
+	changesView viewport.Model
+	helpList    list.Model
+	filterText  textinput.Model
+	helpItems   []helpItem
 
 	// Form state
 	formTitle        textinput.Model
@@ -120,14 +180,67 @@ type Model struct {
 	formAcceptance   textarea.Model
 	formPriority     int
 	formType         string
+	formLabels       textinput.Model
+	formAssignee     textinput.Model
+	formDue          textinput.Model
+	formDefer        textinput.Model
+	formLink         formLink
 	formFocus        int
 	formSubmitBounds formBounds
+	formFieldHeight  int
+	formWarning      string // shown in the form, e.g. when text was truncated
 	editing          bool
 	editingID        string
+	editingOriginal  models.Task
 	editorField      editorField
 	editorTargetID   string
 	editorTargetForm bool
 
+	// Field value and issue timestamp when the external editor was opened,
+	// used to detect concurrent changes before saving
+	editorBaseContent   string
+	editorBaseUpdatedAt time.Time
+	editorMerging       bool // the editor holds conflict markers to resolve
+	conflict            editConflict
+	conflictView        viewport.Model
+
+	// Detail pane tab and the scroll position of every tab
+	detailTab     detailTab
+	detailOffsets [detailTabCount]int
+
+	// Comments by issue ID; fetched holds the issue's UpdatedAt when they
+	// were loaded, so they are fetched again only once the issue changes
+	comments        map[string][]models.Comment
+	commentsErr     map[string]error
+	commentsFetched map[string]time.Time
+
+	// Audit trail by issue ID, fetched like comments
+	history        map[string][]models.Event
+	historyErr     map[string]error
+	historyFetched map[string]time.Time
+
+	// Issue IDs linked in the detail view, the selected one (-1 for none)
+	// and the pattern IDs are found with
+	detailRefs   []issueRef
+	detailLink   int
+	detailLinkID string // issue the link selection belongs to
+	issueRefRe   *regexp.Regexp
+
+	// Issues left by following links, for ctrl+o/ctrl+i
+	navBack    []string
+	navForward []string
+
+	// URLs and files offered by the link picker, and how they are opened
+	pickerLinks      []textLink
+	linkPickerReturn ViewMode
+	linkOpener       string
+	hyperlinks       bool
+
+	// Checklist cursor in the detail pane and the view to return to
+	checklistCursor int
+	checklistReturn ViewMode
+	checklistSaving bool
+
 	// Confirmation
 	confirmMsg    string
 	confirmAction func() tea.Cmd
@@ -149,6 +262,34 @@ type Model struct {
 
 	// Custom commands from config
 	customCommands []config.CustomCommand
+
+	// Issue templates from config
+	templates []config.IssueTemplate
+
+	// Saved views from config and the command palette
+	savedViews     []config.SavedView
+	paletteInput   textinput.Model
+	paletteItems   []paletteItem
+	paletteMatches []paletteItem
+	paletteCursor  int
+	paletteReturn  ViewMode
+	paletteRecent  []string // IDs of recently run entries, most recent first
+
+	// Goto prompt: issues matching the typed ID and the view to return to
+	gotoQuery   string
+	gotoMatches []models.Task
+	gotoCursor  int
+	gotoReturn  ViewMode
+
+	// Session state saved on quit, and the part restored once tasks load
+	sessionStore   *session.Store
+	pendingSession *session.State
+
+	// Crash-safe drafts of the form and external editor sessions
+	draftStore     *drafts.Store
+	savedFormDraft drafts.FormDraft
+	formOpened     drafts.FormDraft // the form as opened, not worth a draft
+	pendingDraft   *drafts.FormDraft
 }
 
 type formBounds struct {
@@ -160,6 +301,17 @@ type formBounds struct {
 
 // New creates a new application model
 func New() Model {
+	// Load config (ignore errors, use empty config) and apply its theme
+	// before styles are copied into components
+	cfg, _ := config.Load()
+	var themeConfig config.ThemeConfig
+	if cfg != nil {
+		themeConfig = cfg.Theme
+		ui.SetDisplay(ui.CurrentDisplay().Merge(ui.Display{ASCII: cfg.Display.ASCII, NoColor: cfg.Display.NoColor}))
+	}
+	theme, themeErr := ResolveTheme(themeConfig)
+	ui.ApplyTheme(theme)
+
 	// Initialize help
 	h := help.New()
 	h.ShowAll = false
@@ -173,6 +325,7 @@ func New() Model {
 
 	// Initialize detail viewport
 	vp := viewport.New(0, 0)
+	vp.MouseWheelEnabled = false // scrolled by handleMouse, which knows the layout
 
 	// Initialize help viewport
 	// Initialize filter input (legacy - can be removed)
@@ -195,12 +348,11 @@ func New() Model {
 	formTitle := textinput.New()
 	formTitle.Prompt = ""
 	formTitle.Placeholder = "Enter a brief, descriptive title for this task"
-	formTitle.CharLimit = 200
 
 	formDesc := textarea.New()
 	formDesc.Prompt = ""
 	formDesc.Placeholder = "Add description or context (optional)"
-	formDesc.CharLimit = 1000
+	formDesc.MaxHeight = 0 // no line cap; the field scrolls past formFieldHeight
 	formDesc.ShowLineNumbers = false
 	formDesc.FocusedStyle.Base = ui.FormInputFocusedStyle
 	formDesc.BlurredStyle.Base = ui.FormInputStyle
@@ -208,7 +360,7 @@ func New() Model {
 	formNotes := textarea.New()
 	formNotes.Prompt = ""
 	formNotes.Placeholder = "Add notes (optional)"
-	formNotes.CharLimit = 1000
+	formNotes.MaxHeight = 0
 	formNotes.ShowLineNumbers = false
 	formNotes.FocusedStyle.Base = ui.FormInputFocusedStyle
 	formNotes.BlurredStyle.Base = ui.FormInputStyle
@@ -216,7 +368,7 @@ func New() Model {
 	formDesign := textarea.New()
 	formDesign.Prompt = ""
 	formDesign.Placeholder = "Add design notes (optional)"
-	formDesign.CharLimit = 1000
+	formDesign.MaxHeight = 0
 	formDesign.ShowLineNumbers = false
 	formDesign.FocusedStyle.Base = ui.FormInputFocusedStyle
 	formDesign.BlurredStyle.Base = ui.FormInputStyle
@@ -224,29 +376,95 @@ func New() Model {
 	formAcceptance := textarea.New()
 	formAcceptance.Prompt = ""
 	formAcceptance.Placeholder = "Add acceptance criteria (optional)"
-	formAcceptance.CharLimit = 1000
+	formAcceptance.MaxHeight = 0
 	formAcceptance.ShowLineNumbers = false
 	formAcceptance.FocusedStyle.Base = ui.FormInputFocusedStyle
 	formAcceptance.BlurredStyle.Base = ui.FormInputStyle
 
-	// Load config (ignore errors, use empty config)
-	cfg, _ := config.Load()
+	formLabels := textinput.New()
+	formLabels.Prompt = ""
+	formLabels.Placeholder = "Comma-separated labels (optional)"
+
+	formAssignee := textinput.New()
+	formAssignee.Prompt = ""
+	formAssignee.Placeholder = "Assignee (optional)"
+
+	formDue := textinput.New()
+	formDue.Prompt = ""
+	formDue.Placeholder = "YYYY-MM-DD, tomorrow, fri, +3d (optional)"
+
+	formDefer := textinput.New()
+	formDefer.Prompt = ""
+	formDefer.Placeholder = "YYYY-MM-DD, tomorrow, fri, +3d (optional)"
+
+	paletteInput := textinput.New()
+	paletteInput.Prompt = ""
+	paletteInput.Placeholder = "Actions, commands, views and issues..."
+	paletteInput.CharLimit = 100
+
 	var customCmds []config.CustomCommand
+	var keybindings config.KeybindingsConfig
+	var templates []config.IssueTemplate
+	var savedViews []config.SavedView
+	formFieldHeight := defaultFormFieldHeight
+	var linkOpener string
+	hyperlinks := hyperlinksSupported()
 	if cfg != nil {
 		customCmds = cfg.CustomCommands
+		keybindings = cfg.Keybindings
+		templates = cfg.Templates
+		savedViews = cfg.Views
+
+		// Character limits are off unless configured
+		formTitle.CharLimit = cfg.Form.TitleLimit
+		formDesc.CharLimit = cfg.Form.TextLimit
+		formNotes.CharLimit = cfg.Form.TextLimit
+		formDesign.CharLimit = cfg.Form.TextLimit
+		formAcceptance.CharLimit = cfg.Form.TextLimit
+		if cfg.Form.FieldHeight > 0 {
+			formFieldHeight = cfg.Form.FieldHeight
+		}
+
+		linkOpener = cfg.Links.Opener
+		if cfg.Links.Hyperlinks != nil {
+			hyperlinks = *cfg.Links.Hyperlinks
+		}
 	}
 
-	// Build key map with custom commands
-	keys := ui.DefaultKeyMap()
-	keys.CustomCommands = buildCustomCommandBindings(customCmds)
+	// Build key maps with configured keybindings and custom commands
+	keys, keyProblems := buildKeyMaps(keybindings, customCmds)
+	var configProblems []string
+	if len(keyProblems) > 0 {
+		configProblems = append(configProblems, "keybindings: "+strings.Join(keyProblems, "; "))
+	}
+	if themeErr != nil {
+		configProblems = append(configProblems, themeErr.Error())
+	}
+	var configErr error
+	if len(configProblems) > 0 {
+		configErr = errors.New(strings.Join(configProblems, "; "))
+	}
 
 	// Build help list
-	helpItems := buildHelpItems(keys, customCmds)
+	helpItems := buildHelpItems(keys.list, keys.detail, customCmds)
 	helpList := newHelpList(helpItems)
 
-	return Model{
+	// Sessions and drafts belong to the repository, wherever in it lazybeads
+	// was started
+	projectDir, err := beads.ProjectDir()
+	if err != nil {
+		configErr = errors.Join(configErr, err)
+		projectDir = "."
+	}
+
+	m := Model{
 		client:          beads.NewClient(),
-		keys:            keys,
+		epicChildren:    &epicChildCache{},
+		linkCache:       &taskLinkCache{},
+		keys:            keys.global,
+		listKeys:        keys.list,
+		detailKeys:      keys.detail,
+		err:             configErr,
 		help:            h,
 		mode:            ViewList,
 		focusedPanel:    FocusInProgress,
@@ -254,6 +472,9 @@ func New() Model {
 		openPanel:       openPanel,
 		closedPanel:     closedPanel,
 		detail:          vp,
+		analysis:        viewport.New(0, 0),
+		changesView:     viewport.New(0, 0),
+		conflictView:    viewport.New(0, 0),
 		helpList:        helpList,
 		filterText:      filter,
 		helpItems:       helpItems,
@@ -264,10 +485,38 @@ func New() Model {
 		formNotes:       formNotes,
 		formDesign:      formDesign,
 		formAcceptance:  formAcceptance,
+		formLabels:      formLabels,
+		formAssignee:    formAssignee,
+		formDue:         formDue,
+		formDefer:       formDefer,
+		formFieldHeight: formFieldHeight,
+		linkOpener:      linkOpener,
+		hyperlinks:      hyperlinks,
 		formPriority:    2,
 		formType:        "feature",
 		customCommands:  customCmds,
+		templates:       templates,
+		savedViews:      savedViews,
+		paletteInput:    paletteInput,
+		draftStore:      drafts.NewStore(projectDir),
+		sessionStore:    session.NewStore(projectDir),
+	}
+
+	// Pick up where the last session in this project left off
+	if state, err := m.sessionStore.Load(); err != nil {
+		m.err = errors.Join(m.err, err)
+	} else if state != nil {
+		m.restoreSession(*state)
+	}
+
+	// Offer to restore a form left unsaved by a crash or closed terminal
+	if draft, err := m.draftStore.LoadForm(); err != nil {
+		m.err = errors.Join(m.err, err)
+	} else if draft != nil {
+		m.openRestoreDraftModal(draft)
 	}
+
+	return m
 }
 
 // buildCustomCommandBindings creates key bindings from custom commands
@@ -299,15 +548,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.KeyMsg:
 		// Global key handling - intercept before components
-		switch msg.String() {
-		case "ctrl+c":
+		switch {
+		case msg.String() == "ctrl+c":
+			m.saveSession()
 			return m, tea.Quit
-		case "q":
+		case key.Matches(msg, m.listKeys.Quit) && m.mode == ViewList:
 			// Only quit from list view
-			if m.mode == ViewList {
-				return m, tea.Quit
-			}
-		case "esc":
+			m.saveSession()
+			return m, tea.Quit
+		case key.Matches(msg, m.keys.Cancel):
 			// If in search mode, exit search mode and clear filter
 			if m.searchMode {
 				m.searchMode = false
@@ -324,6 +573,23 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.mode == ViewHelp && !m.helpFilterActive {
 				m.clearHelpFilter()
 			}
+			if m.mode == ViewPalette {
+				m.closePalette()
+				return m, nil
+			}
+			if m.mode == ViewGoto {
+				m.mode = m.gotoReturn
+				return m, nil
+			}
+			// Leaving a checklist returns to where it was opened from
+			if m.mode == ViewChecklist {
+				m.mode = m.checklistReturn
+				return m, nil
+			}
+			// Cancelling the form discards its draft
+			if m.mode == ViewForm {
+				m.clearFormDraft()
+			}
 			// Escape goes back to list, never quits
 			if m.mode != ViewList {
 				m.mode = ViewList
@@ -346,14 +612,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		// If mode changed or search mode was just activated, don't pass key to child components
 		if m.mode != prevMode || (m.searchMode && !prevSearchMode) {
+			cmds = append(cmds, m.loadDetailDataIfNeeded())
 			return m, tea.Batch(cmds...)
 		}
 
 	case tea.MouseMsg:
-		if m.mode == ViewForm {
-			if cmd := m.handleFormMouse(msg); cmd != nil {
-				cmds = append(cmds, cmd)
-			}
+		if cmd := m.handleMouse(msg); cmd != nil {
+			cmds = append(cmds, cmd)
 		}
 
 	case tasksLoadedMsg:
@@ -363,6 +628,25 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.tasks != nil {
 			m.tasks = msg.tasks
 			applyBlockingDepth(m.tasks)
+			m.cycles = findDependencyCycles(m.tasks)
+			applyEpicChildren(m.tasks)
+			applyChecklistCounts(m.tasks)
+			now := time.Now()
+			if msg.err == nil {
+				// A failed enrichment would show up as changed parents
+				// and blockers, so only complete loads are compared
+				cmds = append(cmds, m.recordChanges(now))
+			}
+			m.markChangedTasks(now)
+			m.issueRefRe = issueRefPattern(m.tasks)
+			m.distributeTasks()
+			if m.pendingSession != nil {
+				m.restoreSessionSelection()
+			}
+		}
+
+	case changeHighlightMsg:
+		if m.markChangedTasks(time.Now()) {
 			m.distributeTasks()
 		}
 
@@ -370,6 +654,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.err != nil {
 			m.err = msg.err
 		} else {
+			if msg.task != nil {
+				m.markOwnEdit(msg.task.ID)
+			}
+			m.markOwnEdit(msg.linkedID)
+			if msg.parentID != "" {
+				if m.expandedEpics == nil {
+					m.expandedEpics = make(map[string]bool)
+				}
+				m.expandedEpics[msg.parentID] = true
+			}
+			if m.mode == ViewForm {
+				m.clearFormDraft()
+			}
 			m.mode = ViewList
 			cmds = append(cmds, m.loadTasks())
 		}
@@ -377,12 +674,61 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case taskUpdatedMsg:
 		if msg.err != nil {
 			m.err = msg.err
+		} else {
+			m.markOwnEdit(msg.taskID)
+		}
+		if msg.err == nil && len(msg.changes) > 0 {
+			if m.mode == ViewForm {
+				m.clearFormDraft()
+			}
+			m.mode = ViewList
+			cmds = append(cmds, m.flashStatus(fmt.Sprintf("Updated %s: %s", msg.taskID, strings.Join(msg.changes, ", "))))
+		}
+		cmds = append(cmds, m.loadTasks())
+
+	case commentsLoadedMsg:
+		if m.comments == nil {
+			m.comments = make(map[string][]models.Comment)
+			m.commentsErr = make(map[string]error)
+		}
+		if msg.err != nil {
+			m.commentsErr[msg.taskID] = msg.err
+		} else {
+			m.comments[msg.taskID] = msg.comments
+			delete(m.commentsErr, msg.taskID)
+		}
+
+	case historyLoadedMsg:
+		if m.history == nil {
+			m.history = make(map[string][]models.Event)
+			m.historyErr = make(map[string]error)
+		}
+		if msg.err != nil {
+			m.historyErr[msg.taskID] = msg.err
+		} else {
+			m.history[msg.taskID] = msg.events
+			delete(m.historyErr, msg.taskID)
+		}
+
+	case linkOpenedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+		}
+
+	case checklistSavedMsg:
+		m.checklistSaving = false
+		if msg.err != nil {
+			m.err = msg.err
+		} else {
+			m.markOwnEdit(msg.taskID)
 		}
 		cmds = append(cmds, m.loadTasks())
 
 	case taskClosedMsg:
 		if msg.err != nil {
 			m.err = msg.err
+		} else {
+			m.markOwnEdit(msg.taskID)
 		}
 		m.mode = ViewList
 		cmds = append(cmds, m.loadTasks())
@@ -390,6 +736,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case taskDeletedMsg:
 		if msg.err != nil {
 			m.err = msg.err
+		} else {
+			m.markOwnEdit(msg.taskID)
 		}
 		m.mode = ViewList
 		cmds = append(cmds, m.loadTasks())
@@ -398,9 +746,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		targetID := m.editorTargetID
 		field := m.editorField
 		targetForm := m.editorTargetForm
+		merging := m.editorMerging
 		m.editorTargetID = ""
 		m.editorField = ""
 		m.editorTargetForm = false
+		m.editorMerging = false
 
 		if msg.err != nil {
 			m.err = msg.err
@@ -415,29 +765,25 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if targetForm {
 			m.applyEditorContentToForm(field, msg.content)
 			m.mode = ViewForm
+			m.saveFormDraft()
+			break
+		}
+
+		if merging && hasConflictMarkers(msg.content) {
+			m.rejectUnresolvedMerge()
 			break
 		}
 
 		if targetID != "" {
-			return m, func() tea.Msg {
-				opts := beads.UpdateOptions{}
-				switch field {
-				case editorFieldDescription:
-					opts.Description = msg.content
-				case editorFieldNotes:
-					opts.Notes = msg.content
-				case editorFieldDesign:
-					opts.Design = msg.content
-				case editorFieldAcceptance:
-					opts.AcceptanceCriteria = msg.content
-				}
-				err := m.client.Update(targetID, opts)
-				return taskUpdatedMsg{err: err}
-			}
+			m.mode = ViewList
+			return m, m.saveEditorContent(targetID, field, m.editorBaseContent, m.editorBaseUpdatedAt, msg.content)
 		}
 
 		m.mode = ViewList
 
+	case editConflictMsg:
+		m.openConflictView(msg.conflict)
+
 	case tickMsg:
 		// Periodic refresh - reload tasks and schedule next tick
 		cmds = append(cmds, m.loadTasks(), pollTick())
@@ -446,10 +792,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.err != nil {
 			m.err = msg.err
 		} else {
-			m.statusMsg = "Copied!"
-			cmds = append(cmds, tea.Tick(statusFlashDuration, func(t time.Time) tea.Msg {
-				return clearStatusMsg{}
-			}))
+			cmds = append(cmds, m.flashStatus("Copied!"))
 		}
 
 	case clearStatusMsg:
@@ -486,8 +829,35 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		var cmd tea.Cmd
 		m.detail, cmd = m.detail.Update(msg)
 		cmds = append(cmds, cmd)
+	case ViewAnalysis:
+		if _, isKey := msg.(tea.KeyMsg); !isKey {
+			var cmd tea.Cmd
+			m.analysis, cmd = m.analysis.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+	case ViewChanges:
+		if _, isKey := msg.(tea.KeyMsg); !isKey {
+			var cmd tea.Cmd
+			m.changesView, cmd = m.changesView.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+	case ViewChecklist:
+		if _, isKey := msg.(tea.KeyMsg); !isKey {
+			var cmd tea.Cmd
+			m.detail, cmd = m.detail.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+	case ViewConflict:
+		if _, isKey := msg.(tea.KeyMsg); !isKey {
+			var cmd tea.Cmd
+			m.conflictView, cmd = m.conflictView.Update(msg)
+			cmds = append(cmds, cmd)
+		}
 	case ViewForm:
 		cmds = append(cmds, m.updateForm(msg))
+		if _, isKey := msg.(tea.KeyMsg); isKey {
+			m.saveFormDraft()
+		}
 	case ViewEditTitle:
 		// Update text input in modal
 		var cmd tea.Cmd
@@ -498,6 +868,27 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		var cmd tea.Cmd
 		m.modal.Input, cmd = m.modal.Input.Update(msg)
 		cmds = append(cmds, cmd)
+	case ViewCapture:
+		var cmd tea.Cmd
+		m.modal.Input, cmd = m.modal.Input.Update(msg)
+		cmds = append(cmds, cmd)
+		if _, isKey := msg.(tea.KeyMsg); isKey {
+			m.updateCapturePreview()
+		}
+	case ViewGoto:
+		var cmd tea.Cmd
+		m.modal.Input, cmd = m.modal.Input.Update(msg)
+		cmds = append(cmds, cmd)
+		if _, isKey := msg.(tea.KeyMsg); isKey {
+			m.updateGotoCompletions()
+		}
+	case ViewPalette:
+		// Keys are handled in handlePaletteKeys
+		if _, isKey := msg.(tea.KeyMsg); !isKey {
+			var cmd tea.Cmd
+			m.paletteInput, cmd = m.paletteInput.Update(msg)
+			cmds = append(cmds, cmd)
+		}
 	case ViewHelp:
 		// Avoid list handling for key messages; handled in handleHelpKeys
 		if _, isKey := msg.(tea.KeyMsg); !isKey {
@@ -512,6 +903,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// Fetch comments and history when their tabs show a new issue
+	cmds = append(cmds, m.loadDetailDataIfNeeded())
+
 	return m, tea.Batch(cmds...)
 }
 
@@ -542,14 +936,14 @@ func (m *Model) updateSizes() {
 		panelWidth, m.detailWidth = m.wideLayoutWidths()
 		m.panelWidth = panelWidth
 		m.detail.Width = maxInt(m.detailWidth-4, 1)
-		m.detail.Height = contentHeight - 2
+		m.detail.Height = maxInt(contentHeight-2-detailTabBarHeight, 1)
 	} else {
 		// Narrow mode: full width panels stacked
 		panelWidth = m.width - 2
 		m.panelWidth = panelWidth
 		m.detailWidth = 0
 		m.detail.Width = m.width - 4
-		m.detail.Height = contentHeight - 2
+		m.detail.Height = maxInt(contentHeight-2-detailTabBarHeight, 1)
 	}
 
 	// Check if Closed panel is collapsed (only when not focused)
@@ -621,6 +1015,10 @@ func (m *Model) updateSizes() {
 	m.formNotes.SetWidth(formWidth)
 	m.formDesign.SetWidth(formWidth)
 	m.formAcceptance.SetWidth(formWidth)
+	m.formLabels.Width = formWidth
+	m.formAssignee.Width = formWidth
+	m.formDue.Width = formWidth
+	m.formDefer.Width = formWidth
 	m.updateFormTextAreaHeights()
 
 	// Update help list size
@@ -636,6 +1034,10 @@ func (m *Model) updateSizes() {
 		helpInputWidth = 10
 	}
 	m.helpFilterInput.Width = helpInputWidth
+
+	m.updateAnalysisSize()
+	m.updateChangesSize()
+	m.updateConflictSize()
 }
 
 func (m *Model) wideLayoutWidths() (panelWidth int, detailWidth int) {
@@ -677,6 +1079,11 @@ func maxInt(a, b int) int {
 }
 
 func (m *Model) distributeTasks() {
+	selectedID := ""
+	if task := m.getSelectedTask(); task != nil {
+		selectedID = task.ID
+	}
+
 	var inProgress, open, closed []models.Task
 	filterLower := strings.ToLower(m.filterQuery)
 	for _, t := range m.tasks {
@@ -717,10 +1124,27 @@ func (m *Model) distributeTasks() {
 	inProgress = orderTasksByBlockingTree(inProgress)
 	open = orderTasksByBlockingTree(open)
 
+	allByID := make(map[string]models.Task, len(m.tasks))
+	for _, t := range m.tasks {
+		allByID[t.ID] = t
+	}
+	lists := nestEpicChildren([][]models.Task{inProgress, open, closed}, allByID, m.expandedEpics)
+	inProgress, open, closed = lists[0], lists[1], lists[2]
+
 	m.inProgressPanel.SetTasks(inProgress)
 	m.openPanel.SetTasks(open)
 	m.closedPanel.SetTasks(closed)
 
+	// Follow the selected issue to the panel it moved to, e.g. when closed
+	if task := m.getSelectedTask(); selectedID != "" && (task == nil || task.ID != selectedID) {
+		for _, focus := range []PanelFocus{FocusInProgress, FocusOpen, FocusClosed} {
+			if focus != m.focusedPanel && m.panel(focus).SelectTaskByID(selectedID) {
+				m.setPanelFocus(focus)
+				break
+			}
+		}
+	}
+
 	// If In Progress panel disappears while focused, move focus to Open panel
 	if m.focusedPanel == FocusInProgress && len(inProgress) == 0 {
 		m.inProgressPanel.SetFocus(false)
@@ -767,19 +1191,6 @@ func (m *Model) cyclePanelFocus(direction int) {
 		return
 	}
 
-	// Track if we're leaving the Closed panel
-	wasClosedFocused := m.focusedPanel == FocusClosed
-
-	// Clear focus from current panel
-	switch m.focusedPanel {
-	case FocusInProgress:
-		m.inProgressPanel.SetFocus(false)
-	case FocusOpen:
-		m.openPanel.SetFocus(false)
-	case FocusClosed:
-		m.closedPanel.SetFocus(false)
-	}
-
 	// Find current panel index in visible panels
 	currentIdx := -1
 	for i, p := range visiblePanels {
@@ -796,7 +1207,24 @@ func (m *Model) cyclePanelFocus(direction int) {
 
 	// Cycle to next visible panel
 	newIdx := (currentIdx + direction + len(visiblePanels)) % len(visiblePanels)
-	m.focusedPanel = visiblePanels[newIdx]
+	m.setPanelFocus(visiblePanels[newIdx])
+}
+
+// setPanelFocus moves focus to the given panel, expanding the Closed panel
+// while it is focused
+func (m *Model) setPanelFocus(focus PanelFocus) {
+	wasClosedFocused := m.focusedPanel == FocusClosed
+
+	// Clear focus from current panel
+	switch m.focusedPanel {
+	case FocusInProgress:
+		m.inProgressPanel.SetFocus(false)
+	case FocusOpen:
+		m.openPanel.SetFocus(false)
+	case FocusClosed:
+		m.closedPanel.SetFocus(false)
+	}
+	m.focusedPanel = focus
 
 	// Set focus on new panel
 	switch m.focusedPanel {
@@ -0,0 +1,37 @@
+package app
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/models"
+)
+
+// useTempDirs keeps config, drafts and sessions of a test out of the
+// user's home
+func useTempDirs(t *testing.T) {
+	t.Helper()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+}
+
+// launchTestModel starts the app in a 120x30 terminal and loads tasks,
+// if any
+func launchTestModel(tasks ...models.Task) Model {
+	m := New()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	m = updated.(Model)
+	if len(tasks) > 0 {
+		updated, _ = m.Update(tasksLoadedMsg{tasks: tasks})
+		m = updated.(Model)
+	}
+	return m
+}
+
+// newTestModel starts the app with tasks loaded and its files in temp dirs
+func newTestModel(t *testing.T, tasks ...models.Task) Model {
+	t.Helper()
+	useTempDirs(t)
+	return launchTestModel(tasks...)
+}
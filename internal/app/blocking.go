@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
 )
 
 func applyBlockingDepth(tasks []models.Task) {
@@ -151,15 +152,15 @@ func buildTreePrefix(ancestors []bool, hasNext bool) string {
 	var b strings.Builder
 	for _, ancestorHasNext := range ancestors {
 		if ancestorHasNext {
-			b.WriteString("│  ")
+			b.WriteString(ui.Glyphs.TreePipe)
 		} else {
 			b.WriteString("   ")
 		}
 	}
 	if hasNext {
-		b.WriteString("├─ ")
+		b.WriteString(ui.Glyphs.TreeBranch)
 	} else {
-		b.WriteString("└─ ")
+		b.WriteString(ui.Glyphs.TreeLast)
 	}
 	return b.String()
 }
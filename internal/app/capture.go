@@ -0,0 +1,268 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"lazybeads/internal/beads"
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+var captureTypes = []string{"task", "bug", "feature", "epic", "chore"}
+
+// captureSpec is the result of parsing a quick-capture line
+type captureSpec struct {
+	opts beads.CreateOptions
+	link formLink
+}
+
+// parseCaptureLine turns a one-line capture such as
+//
+//	Login fails on Safari !1 #bug +frontend @alice ^tomorrow blocks:lazybeads-42
+//
+// into create options. Words without a marker form the title; a leading
+// backslash keeps a marker word literal (\#1 stays "#1").
+func parseCaptureLine(line string, now time.Time) (captureSpec, error) {
+	spec := captureSpec{
+		opts: beads.CreateOptions{Type: "task", Priority: 2},
+	}
+
+	var titleWords []string
+	setLink := func(kind linkKind, target string) error {
+		if target == "" {
+			return fmt.Errorf("missing issue ID after %s:", kind)
+		}
+		if spec.link.targetID != "" {
+			return fmt.Errorf("only one link is supported (got %s and %s)", spec.link.targetID, target)
+		}
+		spec.link = formLink{kind: kind, targetID: target}
+		return nil
+	}
+
+	for _, word := range strings.Fields(line) {
+		if strings.HasPrefix(word, `\`) && len(word) > 1 {
+			titleWords = append(titleWords, word[1:])
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(word, "!") && len(word) > 1:
+			priority, err := strconv.Atoi(word[1:])
+			if err != nil || priority < 0 || priority > 4 {
+				return spec, fmt.Errorf("priority must be !0 to !4, got %s", word)
+			}
+			spec.opts.Priority = priority
+
+		case strings.HasPrefix(word, "#") && len(word) > 1:
+			issueType := strings.ToLower(word[1:])
+			if !containsString(captureTypes, issueType) {
+				return spec, fmt.Errorf("unknown type %s (use %s)", word, strings.Join(captureTypes, ", "))
+			}
+			spec.opts.Type = issueType
+
+		case strings.HasPrefix(word, "+") && len(word) > 1:
+			if !containsString(spec.opts.Labels, word[1:]) {
+				spec.opts.Labels = append(spec.opts.Labels, word[1:])
+			}
+
+		case strings.HasPrefix(word, "@") && len(word) > 1:
+			spec.opts.Assignee = word[1:]
+
+		case strings.HasPrefix(word, "^") && len(word) > 1:
+			due, err := parseCaptureDate(word[1:], now)
+			if err != nil {
+				return spec, err
+			}
+			spec.opts.Due = &due
+
+		case strings.HasPrefix(word, "blocks:"):
+			if err := setLink(linkBlocker, strings.TrimPrefix(word, "blocks:")); err != nil {
+				return spec, err
+			}
+
+		case strings.HasPrefix(word, "after:"):
+			if err := setLink(linkFollowUp, strings.TrimPrefix(word, "after:")); err != nil {
+				return spec, err
+			}
+
+		case strings.HasPrefix(word, "parent:"):
+			if err := setLink(linkChild, strings.TrimPrefix(word, "parent:")); err != nil {
+				return spec, err
+			}
+
+		default:
+			titleWords = append(titleWords, word)
+		}
+	}
+
+	spec.opts.Title = strings.Join(titleWords, " ")
+	if spec.opts.Title == "" {
+		return spec, fmt.Errorf("title is required")
+	}
+	return spec, nil
+}
+
+// parseCaptureDate accepts today, tomorrow, weekday names, +Nd/+Nw offsets
+// and YYYY-MM-DD dates.
+func parseCaptureDate(value string, now time.Time) (time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	lower := strings.ToLower(value)
+
+	switch lower {
+	case "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	}
+
+	if strings.HasPrefix(lower, "+") && len(lower) > 2 {
+		n, err := strconv.Atoi(lower[1 : len(lower)-1])
+		if err == nil && n >= 0 {
+			switch lower[len(lower)-1] {
+			case 'd':
+				return today.AddDate(0, 0, n), nil
+			case 'w':
+				return today.AddDate(0, 0, 7*n), nil
+			}
+		}
+	}
+
+	for offset := 1; offset <= 7; offset++ {
+		day := today.AddDate(0, 0, offset)
+		name := strings.ToLower(day.Weekday().String())
+		if lower == name || (len(lower) >= 3 && strings.HasPrefix(name, lower)) {
+			return day, nil
+		}
+	}
+
+	if date, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return date, nil
+	}
+
+	return time.Time{}, fmt.Errorf("unknown date %q (use today, tomorrow, a weekday, +3d, +2w or YYYY-MM-DD)", value)
+}
+
+// resolveIssueID expands a full or short issue ID (the part shortenIssueID
+// displays) to the full ID of a loaded task.
+func (m *Model) resolveIssueID(ref string) (string, bool) {
+	if ref == "" {
+		return "", false
+	}
+	var match string
+	for _, task := range m.tasks {
+		if strings.EqualFold(task.ID, ref) {
+			return task.ID, true
+		}
+		if strings.EqualFold(shortenIssueID(task.ID), ref) {
+			if match != "" && match != task.ID {
+				return "", false
+			}
+			match = task.ID
+		}
+	}
+	return match, match != ""
+}
+
+// taskByID returns the loaded task with the given ID
+func (m *Model) taskByID(id string) (models.Task, bool) {
+	for _, task := range m.tasks {
+		if task.ID == id {
+			return task, true
+		}
+	}
+	return models.Task{}, false
+}
+
+// parseCapture parses the capture input and resolves any linked issue.
+func (m *Model) parseCapture() (captureSpec, error) {
+	spec, err := parseCaptureLine(m.modal.InputValue(), time.Now())
+	if err != nil {
+		return spec, err
+	}
+	if spec.link.targetID != "" {
+		id, ok := m.resolveIssueID(spec.link.targetID)
+		if !ok {
+			return spec, fmt.Errorf("unknown issue %s", spec.link.targetID)
+		}
+		spec.link.targetID = id
+	}
+	return spec, nil
+}
+
+func (m *Model) openCaptureModal() tea.Cmd {
+	m.modal = ui.NewModalInput("Quick Capture", "", "")
+	m.modal.Input.CharLimit = 0
+	m.modal.Input.Placeholder = "Title !1 #bug +label @who ^tomorrow blocks:id"
+	m.updateCapturePreview()
+	m.mode = ViewCapture
+	return m.modal.Input.Focus()
+}
+
+// updateCapturePreview shows the parsed fields below the capture input.
+func (m *Model) updateCapturePreview() {
+	if strings.TrimSpace(m.modal.InputValue()) == "" {
+		m.modal.Body = ui.HelpDescStyle.Render("!0-4 priority  #type  +label  @assignee  ^due\nblocks:ID  after:ID  parent:ID")
+		return
+	}
+
+	spec, err := m.parseCapture()
+	if err != nil {
+		m.modal.Body = ui.ErrorStyle.Render(err.Error())
+		return
+	}
+
+	label := lipgloss.NewStyle().Foreground(ui.ColorSecondary).Bold(true).Width(10)
+	var b strings.Builder
+	row := func(name, value string) {
+		b.WriteString(label.Render(name) + value + "\n")
+	}
+	row("Title:", spec.opts.Title)
+	row("Type:", spec.opts.Type)
+	row("Priority:", ui.PriorityStyle(spec.opts.Priority).Render(fmt.Sprintf("P%d", spec.opts.Priority)))
+	if len(spec.opts.Labels) > 0 {
+		row("Labels:", strings.Join(spec.opts.Labels, ", "))
+	}
+	if spec.opts.Assignee != "" {
+		row("Assignee:", spec.opts.Assignee)
+	}
+	if spec.opts.Due != nil {
+		row("Due:", spec.opts.Due.Format("Mon 2006-01-02"))
+	}
+	if spec.link.targetID != "" {
+		target := spec.link.targetID
+		if task, ok := m.taskByID(target); ok {
+			target += " " + task.Title
+		}
+		row("Link:", fmt.Sprintf("%s of %s", spec.link.kind, target))
+	}
+	m.modal.Body = strings.TrimRight(b.String(), "\n")
+}
+
+func (m *Model) handleCaptureKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		spec, err := m.parseCapture()
+		if err != nil {
+			m.modal.Body = ui.ErrorStyle.Render(err.Error())
+			return nil
+		}
+		m.mode = ViewList
+		parentID := ""
+		if spec.link.kind == linkChild {
+			parentID = spec.link.targetID
+		}
+		return func() tea.Msg {
+			task, err := createLinkedTask(m.client, spec.opts, spec.link)
+			return taskCreatedMsg{task: task, parentID: parentID, linkedID: spec.link.targetID, err: err}
+		}
+	case "esc":
+		m.mode = ViewList
+	}
+	return nil
+}
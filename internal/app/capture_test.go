@@ -0,0 +1,94 @@
+package app
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCaptureLine(t *testing.T) {
+	now := time.Date(2026, 10, 14, 9, 30, 0, 0, time.UTC) // Wednesday
+
+	spec, err := parseCaptureLine("Login fails on Safari !1 #bug +frontend @alice ^tomorrow blocks:lazybeads-42", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if spec.opts.Title != "Login fails on Safari" {
+		t.Errorf("expected title 'Login fails on Safari', got '%s'", spec.opts.Title)
+	}
+	if spec.opts.Priority != 1 {
+		t.Errorf("expected priority 1, got %d", spec.opts.Priority)
+	}
+	if spec.opts.Type != "bug" {
+		t.Errorf("expected type 'bug', got '%s'", spec.opts.Type)
+	}
+	if len(spec.opts.Labels) != 1 || spec.opts.Labels[0] != "frontend" {
+		t.Errorf("expected labels [frontend], got %v", spec.opts.Labels)
+	}
+	if spec.opts.Assignee != "alice" {
+		t.Errorf("expected assignee 'alice', got '%s'", spec.opts.Assignee)
+	}
+	if spec.opts.Due == nil || spec.opts.Due.Format("2006-01-02") != "2026-10-15" {
+		t.Errorf("expected due 2026-10-15, got %v", spec.opts.Due)
+	}
+	if spec.link.kind != linkBlocker || spec.link.targetID != "lazybeads-42" {
+		t.Errorf("expected blocker link to lazybeads-42, got %+v", spec.link)
+	}
+}
+
+func TestParseCaptureLineDefaults(t *testing.T) {
+	spec, err := parseCaptureLine(`Fix \#1 in C# docs`, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spec.opts.Title != "Fix #1 in C# docs" {
+		t.Errorf("expected escaped marker to stay in title, got '%s'", spec.opts.Title)
+	}
+	if spec.opts.Type != "task" || spec.opts.Priority != 2 {
+		t.Errorf("expected task/P2 defaults, got %s/P%d", spec.opts.Type, spec.opts.Priority)
+	}
+	if spec.link.targetID != "" || spec.opts.Due != nil {
+		t.Errorf("expected no link or due date, got %+v", spec)
+	}
+}
+
+func TestParseCaptureLineErrors(t *testing.T) {
+	tests := []string{
+		"",
+		"!1 #bug",
+		"Title !7",
+		"Title #story",
+		"Title ^someday",
+		"Title blocks:a-1 parent:a-2",
+		"Title blocks:",
+	}
+	for _, line := range tests {
+		if _, err := parseCaptureLine(line, time.Now()); err == nil {
+			t.Errorf("expected error for %q", line)
+		}
+	}
+}
+
+func TestParseCaptureDate(t *testing.T) {
+	now := time.Date(2026, 10, 14, 9, 30, 0, 0, time.UTC) // Wednesday
+
+	tests := map[string]string{
+		"today":      "2026-10-14",
+		"tomorrow":   "2026-10-15",
+		"+3d":        "2026-10-17",
+		"+2w":        "2026-10-28",
+		"fri":        "2026-10-16",
+		"wednesday":  "2026-10-21",
+		"2026-12-01": "2026-12-01",
+	}
+	for input, want := range tests {
+		got, err := parseCaptureDate(input, now)
+		if err != nil {
+			t.Errorf("parseCaptureDate(%q) returned error: %v", input, err)
+			continue
+		}
+		if got.Format("2006-01-02") != want {
+			t.Errorf("parseCaptureDate(%q) = %s, want %s", input, got.Format("2006-01-02"), want)
+		}
+	}
+}
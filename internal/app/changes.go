@@ -0,0 +1,349 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+const (
+	// changeHighlightDuration is how long a changed row stays highlighted
+	changeHighlightDuration = 5 * time.Second
+
+	// changeLogMax is how many changes the recent changes log keeps
+	changeLogMax = 200
+
+	// ownEditWindow is how long an edit made from lazybeads is waited for
+	// in the refreshes, to keep it out of the changes
+	ownEditWindow = 10 * time.Second
+)
+
+type changeKind int
+
+const (
+	changeNew changeKind = iota
+	changeStatus
+	changeClosed
+	changeDeleted
+	changeFields
+)
+
+var changeKindLabels = map[changeKind]string{
+	changeNew:     "new",
+	changeStatus:  "status",
+	changeClosed:  "closed",
+	changeDeleted: "deleted",
+	changeFields:  "updated",
+}
+
+// taskChange is one difference between two refreshes of the issue list
+type taskChange struct {
+	at     time.Time
+	kind   changeKind
+	id     string
+	title  string
+	detail string // the status move or the changed fields
+}
+
+// changeHighlightMsg is sent when the oldest row highlights may have expired
+type changeHighlightMsg struct{}
+
+// changeFieldChecks name the fields compared for an "updated" change.
+// Fields derived by the app, such as tree prefixes, are left out.
+var changeFieldChecks = []struct {
+	name    string
+	changed func(a, b models.Task) bool
+}{
+	{"title", func(a, b models.Task) bool { return a.Title != b.Title }},
+	{"priority", func(a, b models.Task) bool { return a.Priority != b.Priority }},
+	{"type", func(a, b models.Task) bool { return a.Type != b.Type }},
+	{"assignee", func(a, b models.Task) bool { return a.Assignee != b.Assignee }},
+	{"labels", func(a, b models.Task) bool { return !sameSet(a.Labels, b.Labels) }},
+	{"description", func(a, b models.Task) bool { return a.Description != b.Description }},
+	{"notes", func(a, b models.Task) bool { return a.Notes != b.Notes }},
+	{"design", func(a, b models.Task) bool { return a.Design != b.Design }},
+	{"acceptance", func(a, b models.Task) bool { return a.AcceptanceCriteria != b.AcceptanceCriteria }},
+	{"due", func(a, b models.Task) bool { return !sameTime(a.DueDate, b.DueDate) }},
+	{"defer", func(a, b models.Task) bool { return !sameTime(a.DeferUntil, b.DeferUntil) }},
+	{"parent", func(a, b models.Task) bool { return a.Parent != b.Parent }},
+	{"blockers", func(a, b models.Task) bool { return !sameSet(a.BlockedBy, b.BlockedBy) }},
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// sameSet reports whether a and b hold the same values in any order
+func sameSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	if len(a) == 0 {
+		return true
+	}
+	counts := make(map[string]int, len(a))
+	for _, value := range a {
+		counts[value]++
+	}
+	for _, value := range b {
+		if counts[value] == 0 {
+			return false
+		}
+		counts[value]--
+	}
+	return true
+}
+
+// diffTasks lists what changed from one refresh to the next, in ID order
+func diffTasks(previous, current []models.Task, at time.Time) []taskChange {
+	before := make(map[string]models.Task, len(previous))
+	for _, task := range previous {
+		before[task.ID] = task
+	}
+
+	var changes []taskChange
+	seen := make(map[string]bool, len(current))
+	for _, task := range current {
+		seen[task.ID] = true
+		change := taskChange{at: at, id: task.ID, title: task.Title}
+		old, existed := before[task.ID]
+		switch {
+		case !existed:
+			change.kind = changeNew
+		case old.Status != task.Status && task.Status == "closed":
+			change.kind = changeClosed
+			change.detail = task.CloseReason
+		case old.Status != task.Status:
+			change.kind = changeStatus
+			change.detail = old.Status + " " + ui.Glyphs.Arrow + " " + task.Status
+		default:
+			var fields []string
+			for _, check := range changeFieldChecks {
+				if check.changed(old, task) {
+					fields = append(fields, check.name)
+				}
+			}
+			if len(fields) == 0 {
+				continue
+			}
+			change.kind = changeFields
+			change.detail = strings.Join(fields, ", ")
+		}
+		changes = append(changes, change)
+	}
+	for _, task := range previous {
+		if !seen[task.ID] {
+			changes = append(changes, taskChange{at: at, kind: changeDeleted, id: task.ID, title: task.Title})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].id < changes[j].id
+	})
+	return changes
+}
+
+// markOwnEdit notes that an issue was changed from lazybeads, so the
+// refresh showing the change doesn't report it
+func (m *Model) markOwnEdit(id string) {
+	if id == "" {
+		return
+	}
+	if m.ownEdits == nil {
+		m.ownEdits = make(map[string]time.Time)
+	}
+	m.ownEdits[id] = time.Now().Add(ownEditWindow)
+}
+
+// externalChanges drops the changes made from lazybeads, each once, and
+// forgets own edits no refresh has shown in time
+func (m *Model) externalChanges(changes []taskChange, now time.Time) []taskChange {
+	var external []taskChange
+	for _, change := range changes {
+		if _, own := m.ownEdits[change.id]; own {
+			delete(m.ownEdits, change.id)
+			continue
+		}
+		external = append(external, change)
+	}
+	for id, until := range m.ownEdits {
+		if !now.Before(until) {
+			delete(m.ownEdits, id)
+		}
+	}
+	return external
+}
+
+// recordChanges logs the differences a refresh brought against the last
+// complete load and highlights the changed rows. The first load has nothing
+// to compare against.
+func (m *Model) recordChanges(now time.Time) tea.Cmd {
+	previous := m.changeBase
+	m.changeBase = make([]models.Task, len(m.tasks))
+	copy(m.changeBase, m.tasks)
+	if previous == nil {
+		return nil
+	}
+	changes := m.externalChanges(diffTasks(previous, m.tasks, now), now)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	// Newest first
+	m.changeLog = append(changes, m.changeLog...)
+	if len(m.changeLog) > changeLogMax {
+		m.changeLog = m.changeLog[:changeLogMax]
+	}
+	m.unseenChanges += len(changes)
+
+	if m.changedUntil == nil {
+		m.changedUntil = make(map[string]time.Time)
+	}
+	for _, change := range changes {
+		if change.kind != changeDeleted {
+			m.changedUntil[change.id] = now.Add(changeHighlightDuration)
+		}
+	}
+	return tea.Tick(changeHighlightDuration, func(time.Time) tea.Msg {
+		return changeHighlightMsg{}
+	})
+}
+
+// markChangedTasks flags the issues whose highlight has not expired yet
+// and forgets the expired ones. It reports whether any flag changed.
+func (m *Model) markChangedTasks(now time.Time) bool {
+	for id, until := range m.changedUntil {
+		if !now.Before(until) {
+			delete(m.changedUntil, id)
+		}
+	}
+
+	updated := false
+	for i := range m.tasks {
+		_, changed := m.changedUntil[m.tasks[i].ID]
+		if m.tasks[i].Changed != changed {
+			m.tasks[i].Changed = changed
+			updated = true
+		}
+	}
+	return updated
+}
+
+// changesBadge is the status bar count of changes not yet seen in the log
+func (m Model) changesBadge() string {
+	if m.unseenChanges == 0 {
+		return ""
+	}
+	noun := "changes"
+	if m.unseenChanges == 1 {
+		noun = "change"
+	}
+	badge := lipgloss.NewStyle().Foreground(ui.ColorAccent).Render(fmt.Sprintf("%s %d %s", ui.Glyphs.Changed, m.unseenChanges, noun))
+	return badge + " " + ui.HelpKeyStyle.Render(firstBindingKey(m.listKeys.Changes.Keys())) + ":" + ui.HelpDescStyle.Render("show")
+}
+
+// openChanges shows the recent changes log and marks every change seen
+func (m *Model) openChanges() {
+	m.updateChangesSize()
+	m.changesView.SetContent(renderChangeLog(m.changeLog, m.changesView.Width))
+	m.changesView.GotoTop()
+	m.unseenChanges = 0
+	m.mode = ViewChanges
+}
+
+func renderChangeLog(log []taskChange, width int) string {
+	muted := lipgloss.NewStyle().Foreground(ui.ColorMuted)
+	if len(log) == 0 {
+		return muted.Render("No changes since lazybeads started")
+	}
+
+	kindStyles := map[changeKind]lipgloss.Style{
+		changeNew:     lipgloss.NewStyle().Foreground(ui.ColorPrimary),
+		changeStatus:  lipgloss.NewStyle().Foreground(ui.ColorWarning),
+		changeClosed:  lipgloss.NewStyle().Foreground(ui.ColorMuted),
+		changeDeleted: lipgloss.NewStyle().Foreground(ui.ColorDanger),
+		changeFields:  lipgloss.NewStyle().Foreground(ui.ColorAccent),
+	}
+
+	var b strings.Builder
+	for i, change := range log {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		text := fmt.Sprintf("%s %s %s %s",
+			muted.Render(change.at.Format("15:04:05")),
+			kindStyles[change.kind].Render(fmt.Sprintf("%-7s", changeKindLabels[change.kind])),
+			muted.Render(change.id),
+			change.title)
+		if change.detail != "" {
+			text += muted.Render(" (" + change.detail + ")")
+		}
+		b.WriteString(lipgloss.NewStyle().MaxWidth(width).Render(text))
+	}
+	return b.String()
+}
+
+func (m *Model) updateChangesSize() {
+	width, height := helpModalSize(m.width, m.height)
+	m.changesView.Width = maxInt(width-4, 1)
+	m.changesView.Height = maxInt(height-4, 1)
+}
+
+func (m *Model) handleChangesKeys(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, m.keys.Cancel), key.Matches(msg, m.listKeys.Changes), msg.String() == "q":
+		m.mode = ViewList
+	case key.Matches(msg, m.keys.Up):
+		m.changesView.ScrollUp(1)
+	case key.Matches(msg, m.keys.Down):
+		m.changesView.ScrollDown(1)
+	case key.Matches(msg, m.keys.PageUp):
+		m.changesView.HalfPageUp()
+	case key.Matches(msg, m.keys.PageDown):
+		m.changesView.HalfPageDown()
+	case key.Matches(msg, m.keys.Top):
+		m.changesView.GotoTop()
+	case key.Matches(msg, m.keys.Bottom):
+		m.changesView.GotoBottom()
+	}
+	return nil
+}
+
+func (m Model) viewChanges() string {
+	width, height := helpModalSize(m.width, m.height)
+
+	var b strings.Builder
+	b.WriteString(ui.TitleStyle.Render("Recent Changes"))
+	b.WriteString("\n")
+	b.WriteString(m.changesView.View())
+	b.WriteString("\n")
+	closeKeys := "q/" + firstBindingKey(m.listKeys.Changes.Keys()) + "/esc"
+	helpParts := []string{
+		ui.HelpKeyStyle.Render("j/k") + ":" + ui.HelpDescStyle.Render("scroll"),
+		ui.HelpKeyStyle.Render("^u/^d") + ":" + ui.HelpDescStyle.Render("page"),
+		ui.HelpKeyStyle.Render(closeKeys) + ":" + ui.HelpDescStyle.Render("close"),
+	}
+	b.WriteString(ui.HelpBarStyle.Render(strings.Join(helpParts, "  ")))
+
+	modal := ui.OverlayStyle.Padding(0, 1).
+		Width(width).
+		Height(height).
+		Render(b.String())
+
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		modal,
+	)
+}
@@ -0,0 +1,141 @@
+package app
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/models"
+)
+
+func TestDiffTasks(t *testing.T) {
+	previous := []models.Task{
+		{ID: "lazybeads-1", Title: "Same", Status: "open"},
+		{ID: "lazybeads-2", Title: "Started", Status: "open"},
+		{ID: "lazybeads-3", Title: "Done", Status: "in_progress"},
+		{ID: "lazybeads-4", Title: "Gone", Status: "open"},
+		{ID: "lazybeads-5", Title: "Edited", Status: "open", Priority: 2, Labels: []string{"ui"}},
+	}
+	current := []models.Task{
+		{ID: "lazybeads-1", Title: "Same", Status: "open", TreePrefix: "├─ "},
+		{ID: "lazybeads-2", Title: "Started", Status: "in_progress"},
+		{ID: "lazybeads-3", Title: "Done", Status: "closed", CloseReason: "shipped"},
+		{ID: "lazybeads-5", Title: "Edited again", Status: "open", Priority: 1, Labels: []string{"ui"}},
+		{ID: "lazybeads-6", Title: "Fresh", Status: "open"},
+	}
+
+	changes := diffTasks(previous, current, time.Now())
+	expected := []struct {
+		id     string
+		kind   changeKind
+		detail string
+	}{
+		{"lazybeads-2", changeStatus, "open"},
+		{"lazybeads-3", changeClosed, "shipped"},
+		{"lazybeads-4", changeDeleted, ""},
+		{"lazybeads-5", changeFields, "title, priority"},
+		{"lazybeads-6", changeNew, ""},
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %+v", len(expected), changes)
+	}
+	for i, want := range expected {
+		got := changes[i]
+		if got.id != want.id || got.kind != want.kind || !strings.Contains(got.detail, want.detail) {
+			t.Errorf("change %d: expected %+v, got %+v", i, want, got)
+		}
+	}
+}
+
+func TestSameSet(t *testing.T) {
+	tests := []struct {
+		a, b []string
+		want bool
+	}{
+		{nil, []string{}, true},
+		{[]string{"x", "y"}, []string{"y", "x"}, true},
+		{[]string{"x", "y"}, []string{"x"}, false},
+		{[]string{"x", "y"}, []string{"x", "x"}, false},
+	}
+	for _, tt := range tests {
+		if got := sameSet(tt.a, tt.b); got != tt.want {
+			t.Errorf("sameSet(%q, %q) = %v, expected %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestRefreshHighlightsAndLogsChanges(t *testing.T) {
+	m := newTestModel(t)
+	load := func(tasks ...models.Task) {
+		updated, _ := m.Update(tasksLoadedMsg{tasks: tasks})
+		m = updated.(Model)
+	}
+
+	load(models.Task{ID: "lazybeads-1", Title: "First", Status: "open"})
+	if len(m.changeLog) != 0 || m.unseenChanges != 0 {
+		t.Fatalf("expected the first load to count as no change, got %+v", m.changeLog)
+	}
+
+	load(models.Task{ID: "lazybeads-1", Title: "First", Status: "open"},
+		models.Task{ID: "lazybeads-2", Title: "Second", Status: "open"})
+	if m.unseenChanges != 1 || len(m.changeLog) != 1 || m.changeLog[0].kind != changeNew {
+		t.Fatalf("expected one new issue logged, got %+v", m.changeLog)
+	}
+	if !strings.Contains(m.renderStatusBar(), "1 change") {
+		t.Errorf("expected the change count in the status bar, got %q", m.renderStatusBar())
+	}
+	if tasks := m.openPanel.tasks; len(tasks) != 2 || tasks[0].Changed || !tasks[1].Changed {
+		t.Errorf("expected only the new row highlighted, got %+v", tasks)
+	}
+
+	// The highlight fades while the log keeps the change
+	m.changedUntil["lazybeads-2"] = time.Now().Add(-time.Second)
+	updated, _ := m.Update(changeHighlightMsg{})
+	m = updated.(Model)
+	if m.openPanel.tasks[1].Changed {
+		t.Error("expected the highlight to expire")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("W")})
+	m = updated.(Model)
+	if m.mode != ViewChanges || m.unseenChanges != 0 {
+		t.Fatalf("expected the changes log with the count cleared, got mode %v and %d unseen", m.mode, m.unseenChanges)
+	}
+	if view := m.View(); !strings.Contains(view, "lazybeads-2") || !strings.Contains(view, "Second") {
+		t.Errorf("expected the new issue in the log, got %q", view)
+	}
+}
+
+func TestOwnEditsAndFailedLoadsAreNotReported(t *testing.T) {
+	task := models.Task{ID: "lazybeads-1", Title: "First", Status: "open", BlockedBy: []string{"lazybeads-9"}}
+	m := newTestModel(t, task)
+	update := func(msg tea.Msg) {
+		updated, _ := m.Update(msg)
+		m = updated.(Model)
+	}
+
+	update(taskUpdatedMsg{taskID: task.ID, changes: []string{"title"}})
+	task.Title = "Renamed here"
+	update(tasksLoadedMsg{tasks: []models.Task{task}})
+	if len(m.changeLog) != 0 {
+		t.Fatalf("expected an edit made from lazybeads not to be reported, got %+v", m.changeLog)
+	}
+
+	// Listing blocked issues failed, so the blockers are missing once
+	partial := task
+	partial.BlockedBy = nil
+	update(tasksLoadedMsg{tasks: []models.Task{partial}, err: errors.New("bd blocked failed")})
+	update(tasksLoadedMsg{tasks: []models.Task{task}})
+	if len(m.changeLog) != 0 {
+		t.Errorf("expected no change reported around a failed load, got %+v", m.changeLog)
+	}
+
+	task.Title = "Renamed elsewhere"
+	update(tasksLoadedMsg{tasks: []models.Task{task}})
+	if len(m.changeLog) != 1 || m.changeLog[0].detail != "title" {
+		t.Errorf("expected the external edit reported, got %+v", m.changeLog)
+	}
+}
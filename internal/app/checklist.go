@@ -0,0 +1,263 @@
+package app
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+// checklistLineRe matches a markdown task list item such as "- [x] done"
+var checklistLineRe = regexp.MustCompile(`^(\s*[-*+]\s+\[)([ xX])(\]\s+)(.*)$`)
+
+// checklistItem is one checkbox found in an issue field
+type checklistItem struct {
+	field   editorField
+	line    int // line number within the field text
+	checked bool
+	text    string
+}
+
+// checklistSavedMsg is sent when a toggled checkbox has been written back
+type checklistSavedMsg struct {
+	taskID string
+	err    error
+}
+
+// parseChecklist finds markdown checkboxes in text, skipping fenced code
+// blocks.
+func parseChecklist(field editorField, text string) []checklistItem {
+	var items []checklistItem
+	inFence := false
+	for i, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		match := checklistLineRe.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		items = append(items, checklistItem{
+			field:   field,
+			line:    i,
+			checked: match[2] != " ",
+			text:    strings.TrimSpace(match[4]),
+		})
+	}
+	return items
+}
+
+// setChecklistLine marks the checkbox on line as checked or unchecked. The
+// line must still hold a checkbox with the given text, so an edit made
+// elsewhere in the meantime is never overwritten by a stale toggle.
+func setChecklistLine(text string, line int, itemText string, checked bool) (string, error) {
+	lines := strings.Split(text, "\n")
+	if line < 0 || line >= len(lines) {
+		return "", fmt.Errorf("checklist item %q no longer exists", itemText)
+	}
+	match := checklistLineRe.FindStringSubmatch(lines[line])
+	if match == nil || strings.TrimSpace(match[4]) != itemText {
+		return "", fmt.Errorf("checklist item %q was changed elsewhere", itemText)
+	}
+	mark := " "
+	if checked {
+		mark = "x"
+	}
+	lines[line] = match[1] + mark + match[3] + match[4]
+	return strings.Join(lines, "\n"), nil
+}
+
+// taskChecklist returns the checkboxes of the acceptance criteria followed by
+// those of the description
+func taskChecklist(task models.Task) []checklistItem {
+	items := parseChecklist(editorFieldAcceptance, task.AcceptanceCriteria)
+	return append(items, parseChecklist(editorFieldDescription, task.Description)...)
+}
+
+// countChecklist returns how many checkboxes are ticked and how many there are
+func countChecklist(items []checklistItem) (done, total int) {
+	for _, item := range items {
+		if item.checked {
+			done++
+		}
+	}
+	return done, len(items)
+}
+
+// applyChecklistCounts records checklist progress on every task
+func applyChecklistCounts(tasks []models.Task) {
+	for i := range tasks {
+		tasks[i].ChecklistDone, tasks[i].ChecklistTotal = countChecklist(taskChecklist(tasks[i]))
+	}
+}
+
+// checklistProgressText returns "done/total" for tasks with checkboxes
+func checklistProgressText(task models.Task) string {
+	if task.ChecklistTotal == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", task.ChecklistDone, task.ChecklistTotal)
+}
+
+// openChecklist moves the cursor onto the selected issue's checkboxes
+func (m *Model) openChecklist() tea.Cmd {
+	task := m.getSelectedTask()
+	if m.mode == ViewDetail && m.selected != nil {
+		task = m.selected
+	}
+	if task == nil {
+		return nil
+	}
+	if len(taskChecklist(*task)) == 0 {
+		return m.flashStatus("No checklist items in " + task.ID)
+	}
+
+	m.selected = task
+	m.checklistReturn = m.mode
+	m.checklistCursor = 0
+	m.mode = ViewChecklist
+	cmd := m.setDetailTab(detailTabText)
+	m.detail.GotoTop()
+	return cmd
+}
+
+func (m *Model) handleChecklistKeys(msg tea.KeyMsg) tea.Cmd {
+	if m.selected == nil {
+		m.mode = ViewList
+		return nil
+	}
+	items := taskChecklist(*m.selected)
+	if len(items) == 0 {
+		m.mode = m.checklistReturn
+		return nil
+	}
+	m.checklistCursor = minInt(maxInt(m.checklistCursor, 0), len(items)-1)
+
+	switch {
+	case key.Matches(msg, m.keys.Cancel), key.Matches(msg, m.keys.Checklist):
+		m.mode = m.checklistReturn
+		return nil
+	case key.Matches(msg, m.keys.Up):
+		m.checklistCursor = maxInt(m.checklistCursor-1, 0)
+	case key.Matches(msg, m.keys.Down):
+		m.checklistCursor = minInt(m.checklistCursor+1, len(items)-1)
+	case key.Matches(msg, m.keys.Top):
+		m.checklistCursor = 0
+	case key.Matches(msg, m.keys.Bottom):
+		m.checklistCursor = len(items) - 1
+	case msg.String() == " ", msg.String() == "x", msg.String() == "enter":
+		return m.toggleChecklistItem(items[m.checklistCursor])
+	case key.Matches(msg, m.keys.Help):
+		m.mode = ViewHelp
+		return nil
+	default:
+		return nil
+	}
+	m.scrollToChecklistCursor()
+	return nil
+}
+
+// scrollToChecklistCursor keeps the cursor row inside the detail viewport.
+// The checklist is drawn at the top of the detail content, one row per item
+// below its heading.
+func (m *Model) scrollToChecklistCursor() {
+	row := m.checklistCursor + 1
+	if row-1 < m.detail.YOffset {
+		m.detail.SetYOffset(maxInt(row-1, 0))
+	} else if row >= m.detail.YOffset+m.detail.Height {
+		m.detail.SetYOffset(row - m.detail.Height + 1)
+	}
+}
+
+// toggleChecklistItem flips a checkbox locally and writes the field back.
+// Only one write runs at a time so quick toggles cannot overwrite each other.
+func (m *Model) toggleChecklistItem(item checklistItem) tea.Cmd {
+	if m.checklistSaving {
+		return m.flashStatus("Saving checklist" + ui.Glyphs.Ellipsis)
+	}
+	task := m.selected
+	checked := !item.checked
+	updated, err := setChecklistLine(taskFieldValue(*task, item.field), item.line, item.text, checked)
+	if err != nil {
+		m.err = err
+		return nil
+	}
+
+	m.setTaskFieldLocally(task.ID, item.field, updated)
+	m.checklistSaving = true
+
+	client := m.client
+	taskID := task.ID
+	return func() tea.Msg {
+		current, err := client.Show(taskID)
+		if err != nil {
+			return checklistSavedMsg{taskID: taskID, err: err}
+		}
+		content, err := setChecklistLine(taskFieldValue(*current, item.field), item.line, item.text, checked)
+		if err != nil {
+			return checklistSavedMsg{taskID: taskID, err: fmt.Errorf("%w; reloaded %s", err, taskID)}
+		}
+		return checklistSavedMsg{taskID: taskID, err: client.Update(taskID, fieldUpdateOptions(item.field, content))}
+	}
+}
+
+// setTaskFieldLocally updates a field on the selected issue and the loaded
+// task list so the change shows before the write completes
+func (m *Model) setTaskFieldLocally(taskID string, field editorField, value string) {
+	set := func(task *models.Task) {
+		switch field {
+		case editorFieldDescription:
+			task.Description = value
+		case editorFieldAcceptance:
+			task.AcceptanceCriteria = value
+		}
+		task.ChecklistDone, task.ChecklistTotal = countChecklist(taskChecklist(*task))
+	}
+	for i := range m.tasks {
+		if m.tasks[i].ID == taskID {
+			set(&m.tasks[i])
+		}
+	}
+	if m.selected != nil && m.selected.ID == taskID {
+		set(m.selected)
+	}
+}
+
+// renderChecklistSection draws the checkboxes with the cursor row marked
+func (m Model) renderChecklistSection(items []checklistItem, width int) string {
+	var b strings.Builder
+	done, total := countChecklist(items)
+	b.WriteString(ui.DetailLabelStyle.Render(fmt.Sprintf("Checklist (%d/%d):", done, total)))
+	b.WriteString("\n")
+
+	cursorStyle := lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true)
+	for i, item := range items {
+		cursor := "  "
+		if i == m.checklistCursor {
+			cursor = cursorStyle.Render(ui.Glyphs.Cursor)
+		}
+		box := ui.Glyphs.Unchecked
+		if item.checked {
+			box = ui.Glyphs.Checked
+		}
+		text := truncateTitle(item.text, width-4)
+		if i == m.checklistCursor {
+			text = cursorStyle.Render(text)
+		} else if item.checked {
+			text = ui.HelpDescStyle.Render(text)
+		}
+		b.WriteString(cursor + box + " " + text + "\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,85 @@
+package app
+
+import (
+	"testing"
+
+	"lazybeads/internal/models"
+)
+
+func TestParseChecklist(t *testing.T) {
+	text := "Intro\n- [ ] write tests\n  * [x] nested done\n+ [X] upper case\n```\n- [ ] inside code\n```\n- [] not a box\n1. [ ] numbered"
+
+	items := parseChecklist(editorFieldAcceptance, text)
+	if len(items) != 3 {
+		t.Fatalf("expected 3 items, got %d: %+v", len(items), items)
+	}
+
+	expected := []struct {
+		line    int
+		checked bool
+		text    string
+	}{
+		{1, false, "write tests"},
+		{2, true, "nested done"},
+		{3, true, "upper case"},
+	}
+	for i, want := range expected {
+		got := items[i]
+		if got.line != want.line || got.checked != want.checked || got.text != want.text {
+			t.Errorf("item %d: expected %+v, got %+v", i, want, got)
+		}
+		if got.field != editorFieldAcceptance {
+			t.Errorf("item %d: expected field %s, got %s", i, editorFieldAcceptance, got.field)
+		}
+	}
+}
+
+func TestSetChecklistLine(t *testing.T) {
+	text := "- [ ] one\n  - [x] two"
+
+	got, err := setChecklistLine(text, 0, "one", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "- [x] one\n  - [x] two" {
+		t.Errorf("unexpected text after checking: %q", got)
+	}
+
+	got, err = setChecklistLine(text, 1, "two", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "- [ ] one\n  - [ ] two" {
+		t.Errorf("unexpected text after unchecking: %q", got)
+	}
+
+	if _, err := setChecklistLine(text, 0, "renamed", true); err == nil {
+		t.Error("expected error when the item text changed")
+	}
+	if _, err := setChecklistLine(text, 5, "one", true); err == nil {
+		t.Error("expected error when the line is gone")
+	}
+}
+
+func TestApplyChecklistCounts(t *testing.T) {
+	tasks := []models.Task{
+		{ID: "a", AcceptanceCriteria: "- [x] done\n- [ ] todo", Description: "- [x] also done"},
+		{ID: "b", Description: "no boxes"},
+	}
+	applyChecklistCounts(tasks)
+
+	if tasks[0].ChecklistDone != 2 || tasks[0].ChecklistTotal != 3 {
+		t.Errorf("expected 2/3, got %d/%d", tasks[0].ChecklistDone, tasks[0].ChecklistTotal)
+	}
+	if checklistProgressText(tasks[0]) != "2/3" {
+		t.Errorf("expected progress 2/3, got %q", checklistProgressText(tasks[0]))
+	}
+	if checklistProgressText(tasks[1]) != "" {
+		t.Errorf("expected no progress for task without checkboxes, got %q", checklistProgressText(tasks[1]))
+	}
+
+	items := taskChecklist(tasks[0])
+	if items[0].field != editorFieldAcceptance || items[2].field != editorFieldDescription {
+		t.Errorf("expected acceptance criteria items before description items: %+v", items)
+	}
+}
@@ -0,0 +1,315 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"lazybeads/internal/beads"
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+// conflictColumnsMinWidth is the overlay width needed to show the three
+// versions side by side instead of stacked
+const conflictColumnsMinWidth = 90
+
+// editConflict holds the three versions of a field that was changed by
+// someone else while it was open in the external editor
+type editConflict struct {
+	taskID          string
+	field           editorField
+	base            string // value when editing started
+	mine            string // value saved in the editor
+	theirs          string // value currently stored
+	theirsUpdatedAt time.Time
+}
+
+// editConflictMsg is sent when saving editor content would overwrite a
+// concurrent change
+type editConflictMsg struct {
+	conflict editConflict
+}
+
+// editorWrite is what to do with content returned from the external editor
+type editorWrite int
+
+const (
+	editorWriteSave editorWrite = iota
+	editorWriteSkip
+	editorWriteConflict
+)
+
+// resolveEditorWrite decides whether mine can be saved over theirs. base is
+// the value when editing started and changed reports whether the issue was
+// updated since then. Trailing newlines added by editors are ignored.
+func resolveEditorWrite(base, mine, theirs string, changed bool) editorWrite {
+	base = strings.TrimRight(base, "\n")
+	mine = strings.TrimRight(mine, "\n")
+	theirs = strings.TrimRight(theirs, "\n")
+
+	switch {
+	case mine == base:
+		return editorWriteSkip
+	case !changed || theirs == base:
+		return editorWriteSave
+	case theirs == mine:
+		return editorWriteSkip
+	default:
+		return editorWriteConflict
+	}
+}
+
+// taskFieldValue returns the current value of an editor field
+func taskFieldValue(task models.Task, field editorField) string {
+	switch field {
+	case editorFieldDescription:
+		return task.Description
+	case editorFieldNotes:
+		return task.Notes
+	case editorFieldDesign:
+		return task.Design
+	case editorFieldAcceptance:
+		return task.AcceptanceCriteria
+	}
+	return ""
+}
+
+// fieldUpdateOptions builds update options that set one editor field,
+// clearing it when content is empty
+func fieldUpdateOptions(field editorField, content string) beads.UpdateOptions {
+	var opts beads.UpdateOptions
+	if content == "" {
+		flag := string(field)
+		if field == editorFieldAcceptance {
+			flag = "acceptance"
+		}
+		opts.Clear = []string{flag}
+		return opts
+	}
+	switch field {
+	case editorFieldDescription:
+		opts.Description = content
+	case editorFieldNotes:
+		opts.Notes = content
+	case editorFieldDesign:
+		opts.Design = content
+	case editorFieldAcceptance:
+		opts.AcceptanceCriteria = content
+	}
+	return opts
+}
+
+// saveEditorContent re-reads the issue and writes mine only if the field has
+// not been changed by someone else since editing started.
+//
+// The editor content stays in the draft store until it is saved or
+// discarded, so a failed save reopens it the next time the field is edited.
+func (m *Model) saveEditorContent(taskID string, field editorField, base string, baseUpdatedAt time.Time, mine string) tea.Cmd {
+	client := m.client
+	store := m.draftStore
+	return func() tea.Msg {
+		current, err := client.Show(taskID)
+		if err != nil {
+			return taskUpdatedMsg{err: fmt.Errorf("failed to check %s before saving (your edit is kept): %w", taskID, err)}
+		}
+
+		theirs := taskFieldValue(*current, field)
+		switch resolveEditorWrite(base, mine, theirs, !current.UpdatedAt.Equal(baseUpdatedAt)) {
+		case editorWriteSkip:
+			return taskUpdatedMsg{taskID: taskID, err: store.ClearEditor(taskID, string(field))}
+		case editorWriteConflict:
+			return editConflictMsg{conflict: editConflict{
+				taskID:          taskID,
+				field:           field,
+				base:            base,
+				mine:            mine,
+				theirs:          theirs,
+				theirsUpdatedAt: current.UpdatedAt,
+			}}
+		}
+
+		if err := client.Update(taskID, fieldUpdateOptions(field, mine)); err != nil {
+			return taskUpdatedMsg{err: fmt.Errorf("%w (your edit is kept and reopens next time you edit this field)", err)}
+		}
+		err = store.ClearEditor(taskID, string(field))
+		return taskUpdatedMsg{
+			taskID:  taskID,
+			changes: []string{strings.ToLower(editorFieldLabel(field))},
+			err:     err,
+		}
+	}
+}
+
+func (m *Model) openConflictView(conflict editConflict) {
+	m.conflict = conflict
+	m.updateConflictSize()
+	m.conflictView.SetContent(renderEditConflict(conflict, m.conflictView.Width))
+	m.conflictView.GotoTop()
+	m.mode = ViewConflict
+}
+
+func (m *Model) updateConflictSize() {
+	width, height := helpModalSize(m.width, m.height)
+	m.conflictView.Width = maxInt(width-4, 1)
+	m.conflictView.Height = maxInt(height-5, 1)
+	if m.mode == ViewConflict {
+		m.conflictView.SetContent(renderEditConflict(m.conflict, m.conflictView.Width))
+	}
+}
+
+// renderEditConflict shows the original, mine and theirs side by side, or
+// stacked when the overlay is narrow.
+func renderEditConflict(c editConflict, width int) string {
+	type version struct {
+		title string
+		text  string
+	}
+	versions := []version{
+		{"Original", c.base},
+		{"Mine", c.mine},
+		{"Theirs (" + c.theirsUpdatedAt.Local().Format("2006-01-02 15:04") + ")", c.theirs},
+	}
+
+	muted := lipgloss.NewStyle().Foreground(ui.ColorMuted)
+	render := func(v version, w int) string {
+		text := v.text
+		if text == "" {
+			text = muted.Render("(empty)")
+		}
+		return ui.DetailLabelStyle.UnsetWidth().Render(v.title) + "\n" +
+			lipgloss.NewStyle().Width(w).Render(text)
+	}
+
+	if width < conflictColumnsMinWidth {
+		var parts []string
+		for _, v := range versions {
+			parts = append(parts, render(v, width))
+		}
+		return strings.Join(parts, "\n\n")
+	}
+
+	colWidth := (width - 2*3) / 3
+	gap := strings.Repeat(" ", 3)
+	cols := make([]string, 0, len(versions)*2)
+	for i, v := range versions {
+		if i > 0 {
+			cols = append(cols, gap)
+		}
+		cols = append(cols, render(v, colWidth))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, cols...)
+}
+
+// conflictMergeText wraps the three versions in conflict markers for
+// resolving in the external editor
+func conflictMergeText(c editConflict) string {
+	var b strings.Builder
+	section := func(marker, text string) {
+		b.WriteString(marker + "\n")
+		if text != "" {
+			b.WriteString(strings.TrimSuffix(text, "\n") + "\n")
+		}
+	}
+	section("<<<<<<< mine", c.mine)
+	section("||||||| original", c.base)
+	section("=======", c.theirs)
+	b.WriteString(">>>>>>> theirs\n")
+	return b.String()
+}
+
+// conflictMarkers start the lines conflictMergeText adds
+var conflictMarkers = []string{"<<<<<<<", "|||||||", "=======", ">>>>>>>"}
+
+// hasConflictMarkers reports whether merged text still has a marker line,
+// i.e. the merge was not resolved
+func hasConflictMarkers(text string) bool {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, "\r")
+		for _, marker := range conflictMarkers {
+			if line == marker || strings.HasPrefix(line, marker+" ") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rejectUnresolvedMerge keeps merge output with markers out of the issue
+// and goes back to the conflict. The kept draft is reset to mine, so the
+// markers don't reopen the next time the field is edited.
+func (m *Model) rejectUnresolvedMerge() {
+	c := m.conflict
+	m.err = fmt.Errorf("the merged %s still has conflict markers, nothing was saved", strings.ToLower(editorFieldLabel(c.field)))
+	if err := m.draftStore.SaveEditor(c.taskID, string(c.field), c.mine); err != nil {
+		m.err = errors.Join(m.err, err)
+	}
+	m.openConflictView(c)
+}
+
+func (m *Model) handleConflictKeys(msg tea.KeyMsg) tea.Cmd {
+	c := m.conflict
+	switch {
+	case msg.String() == "m":
+		// Keep mine, still guarding against yet another concurrent change
+		m.mode = ViewList
+		return m.saveEditorContent(c.taskID, c.field, c.theirs, c.theirsUpdatedAt, c.mine)
+	case msg.String() == "t":
+		m.mode = ViewList
+		if err := m.draftStore.ClearEditor(c.taskID, string(c.field)); err != nil {
+			m.err = err
+		}
+		return m.flashStatus(fmt.Sprintf("Kept their %s of %s", strings.ToLower(editorFieldLabel(c.field)), c.taskID))
+	case msg.String() == "e":
+		m.mode = ViewList
+		m.editorBaseContent = c.theirs
+		m.editorBaseUpdatedAt = c.theirsUpdatedAt
+		cmd := m.startExternalEditor(c.field, conflictMergeText(c), c.taskID, false)
+		m.editorMerging = cmd != nil
+		return cmd
+	case key.Matches(msg, m.keys.Up):
+		m.conflictView.ScrollUp(1)
+	case key.Matches(msg, m.keys.Down):
+		m.conflictView.ScrollDown(1)
+	case key.Matches(msg, m.keys.PageUp):
+		m.conflictView.HalfPageUp()
+	case key.Matches(msg, m.keys.PageDown):
+		m.conflictView.HalfPageDown()
+	}
+	return nil
+}
+
+func (m Model) viewConflict() string {
+	width, height := helpModalSize(m.width, m.height)
+
+	var b strings.Builder
+	b.WriteString(ui.TitleStyle.Render(fmt.Sprintf("Edit Conflict: %s of %s", editorFieldLabel(m.conflict.field), m.conflict.taskID)))
+	b.WriteString("\n")
+	b.WriteString(ui.WarningStyle.Render("This field was changed by someone else while you were editing it."))
+	b.WriteString("\n")
+	b.WriteString(m.conflictView.View())
+	b.WriteString("\n")
+	helpParts := []string{
+		ui.HelpKeyStyle.Render("m") + ":" + ui.HelpDescStyle.Render("keep mine"),
+		ui.HelpKeyStyle.Render("t") + ":" + ui.HelpDescStyle.Render("keep theirs"),
+		ui.HelpKeyStyle.Render("e") + ":" + ui.HelpDescStyle.Render("merge in $EDITOR"),
+		ui.HelpKeyStyle.Render("j/k") + ":" + ui.HelpDescStyle.Render("scroll"),
+	}
+	b.WriteString(ui.HelpBarStyle.Render(strings.Join(helpParts, "  ")))
+
+	modal := ui.OverlayStyle.Padding(0, 1).
+		Width(width).
+		Height(height).
+		Render(b.String())
+
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		modal,
+	)
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/models"
+)
+
+func TestResolveEditorWrite(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    string
+		mine    string
+		theirs  string
+		changed bool
+		want    editorWrite
+	}{
+		{"unchanged issue", "a", "b", "a", false, editorWriteSave},
+		{"other field changed", "a", "b", "a", true, editorWriteSave},
+		{"no edit made", "a", "a\n", "c", true, editorWriteSkip},
+		{"same edit made elsewhere", "a", "b", "b", true, editorWriteSkip},
+		{"concurrent edit", "a", "b", "c", true, editorWriteConflict},
+	}
+	for _, tt := range tests {
+		if got := resolveEditorWrite(tt.base, tt.mine, tt.theirs, tt.changed); got != tt.want {
+			t.Errorf("%s: expected %d, got %d", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestUnresolvedMergeIsNotSaved(t *testing.T) {
+	m := newTestModel(t, models.Task{ID: "lazybeads-1", Title: "Edited twice", Status: "open"})
+	c := editConflict{taskID: "lazybeads-1", field: editorFieldNotes, base: "a", mine: "b", theirs: "c"}
+	m.openConflictView(c)
+
+	finishMerge := func(content string) (Model, tea.Cmd) {
+		m.mode = ViewList
+		m.editorTargetID = c.taskID
+		m.editorField = c.field
+		m.editorMerging = true
+		updated, cmd := m.Update(editorFinishedMsg{content: content})
+		return updated.(Model), cmd
+	}
+
+	unresolved, cmd := finishMerge(conflictMergeText(c))
+	if cmd != nil || unresolved.err == nil || unresolved.mode != ViewConflict {
+		t.Errorf("expected an error and the conflict view instead of a save, got mode %v, err %v", unresolved.mode, unresolved.err)
+	}
+	if draft, ok := unresolved.draftStore.LoadEditor(c.taskID, string(c.field)); ok && draft != c.mine {
+		t.Errorf("expected the kept draft reset to mine, got %q", draft)
+	}
+
+	resolved, cmd := finishMerge("b and c\n")
+	if cmd == nil || resolved.mode != ViewList {
+		t.Errorf("expected a resolved merge to be saved, got mode %v", resolved.mode)
+	}
+}
@@ -0,0 +1,230 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+// dependencyCycle is a closed chain of blocked_by edges: each issue is
+// blocked by the next one, and the last is blocked by the first.
+type dependencyCycle []string
+
+// findDependencyCycles returns one cycle per strongly connected component of
+// the blocked_by graph that can never become ready.
+func findDependencyCycles(tasks []models.Task) []dependencyCycle {
+	edges := make(map[string][]string, len(tasks))
+	for _, task := range tasks {
+		edges[task.ID] = nil
+	}
+	for _, task := range tasks {
+		for _, blocker := range task.BlockedBy {
+			if _, ok := edges[blocker]; ok {
+				edges[task.ID] = append(edges[task.ID], blocker)
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(edges))
+	for id := range edges {
+		ids = append(ids, id)
+		sort.Strings(edges[id])
+	}
+	sort.Strings(ids)
+
+	var cycles []dependencyCycle
+	for _, component := range stronglyConnected(ids, edges) {
+		sort.Strings(component)
+		start := component[0]
+		if len(component) == 1 && !containsString(edges[start], start) {
+			continue
+		}
+		members := make(map[string]bool, len(component))
+		for _, id := range component {
+			members[id] = true
+		}
+		cycles = append(cycles, shortestCycleFrom(start, edges, members))
+	}
+
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i][0] < cycles[j][0]
+	})
+	return cycles
+}
+
+// stronglyConnected returns the strongly connected components of a graph
+// using Tarjan's algorithm. Each component comes after every component it
+// has an edge to, so the result is in reverse topological order.
+func stronglyConnected(ids []string, edges map[string][]string) [][]string {
+	index := 0
+	indices := make(map[string]int, len(ids))
+	lowlink := make(map[string]int, len(ids))
+	onStack := make(map[string]bool, len(ids))
+	var stack []string
+	var components [][]string
+
+	var strongConnect func(id string)
+	strongConnect = func(id string) {
+		indices[id] = index
+		lowlink[id] = index
+		index++
+		stack = append(stack, id)
+		onStack[id] = true
+
+		for _, next := range edges[id] {
+			if _, seen := indices[next]; !seen {
+				strongConnect(next)
+				lowlink[id] = minInt(lowlink[id], lowlink[next])
+			} else if onStack[next] {
+				lowlink[id] = minInt(lowlink[id], indices[next])
+			}
+		}
+
+		if lowlink[id] != indices[id] {
+			return
+		}
+		var component []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == id {
+				break
+			}
+		}
+		components = append(components, component)
+	}
+
+	for _, id := range ids {
+		if _, seen := indices[id]; !seen {
+			strongConnect(id)
+		}
+	}
+	return components
+}
+
+// shortestCycleFrom walks edges inside one component to find the shortest
+// chain that leads from start back to start.
+func shortestCycleFrom(start string, edges map[string][]string, members map[string]bool) dependencyCycle {
+	prev := map[string]string{}
+	queue := []string{start}
+	visited := map[string]bool{}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, next := range edges[id] {
+			if !members[next] {
+				continue
+			}
+			if next == start {
+				chain := dependencyCycle{id}
+				for chain[0] != start {
+					chain = append(dependencyCycle{prev[chain[0]]}, chain...)
+				}
+				return chain
+			}
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+			prev[next] = id
+			queue = append(queue, next)
+		}
+	}
+	return dependencyCycle{start}
+}
+
+// edges returns the blocked_by edges of the cycle as (issue, blocker) pairs.
+func (c dependencyCycle) edges() [][2]string {
+	pairs := make([][2]string, len(c))
+	for i, id := range c {
+		pairs[i] = [2]string{id, c[(i+1)%len(c)]}
+	}
+	return pairs
+}
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// openCyclesModal shows every detected cycle and lets the user pick an edge to remove.
+func (m *Model) openCyclesModal() {
+	titles := make(map[string]string, len(m.tasks))
+	for _, task := range m.tasks {
+		titles[task.ID] = task.Title
+	}
+
+	var body strings.Builder
+	var options []ui.ModalOption
+	for i, cycle := range m.cycles {
+		if i > 0 {
+			body.WriteString("\n")
+		}
+		chain := append(append([]string{}, cycle...), cycle[0])
+		fmt.Fprintf(&body, "Cycle %d: %s\n", i+1, strings.Join(chain, " "+ui.Glyphs.Arrow+" "))
+		for _, id := range cycle {
+			fmt.Fprintf(&body, "  %s  %s\n", id, titles[id])
+		}
+		for _, edge := range cycle.edges() {
+			options = append(options, ui.ModalOption{
+				Label: fmt.Sprintf("remove: %s blocked by %s", edge[0], edge[1]),
+				Value: edge[0] + " " + edge[1],
+			})
+		}
+	}
+
+	subtitle := fmt.Sprintf("%d found", len(m.cycles))
+	m.modal = ui.NewModalSelect("Dependency Cycles", subtitle, options, "")
+	m.modal.Body = strings.TrimRight(body.String(), "\n")
+	m.mode = ViewCycles
+}
+
+func (m *Model) handleCyclesKeys(msg tea.KeyMsg) tea.Cmd {
+	if key.Matches(msg, m.keys.Cycles) {
+		m.mode = ViewList
+		return nil
+	}
+
+	switch msg.String() {
+	case "k", "up":
+		m.modal.MoveUp()
+	case "j", "down":
+		m.modal.MoveDown()
+	case "enter":
+		issueID, blockerID, ok := strings.Cut(m.modal.SelectedValue(), " ")
+		if !ok {
+			m.mode = ViewList
+			return nil
+		}
+		m.confirmMsg = fmt.Sprintf("Remove dependency: %s no longer blocked by %s?", issueID, blockerID)
+		m.confirmAction = func() tea.Cmd {
+			return func() tea.Msg {
+				err := m.client.RemoveDependency(issueID, blockerID)
+				return taskUpdatedMsg{taskID: issueID, err: err}
+			}
+		}
+		m.mode = ViewConfirm
+	case "esc":
+		m.mode = ViewList
+	}
+	return nil
+}
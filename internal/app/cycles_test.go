@@ -0,0 +1,72 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	"lazybeads/internal/models"
+)
+
+func TestFindDependencyCycles(t *testing.T) {
+	blockedBy := func(id string, blockers ...string) models.Task {
+		return models.Task{ID: id, BlockedBy: blockers}
+	}
+
+	tests := []struct {
+		name   string
+		tasks  []models.Task
+		cycles string
+		edges  string // edges offered for removal, of all cycles
+	}{
+		{
+			name:   "chain without a cycle",
+			tasks:  []models.Task{blockedBy("a", "b"), blockedBy("b", "c"), blockedBy("c")},
+			cycles: "[]",
+			edges:  "[]",
+		},
+		{
+			name:   "self loop",
+			tasks:  []models.Task{blockedBy("a", "a"), blockedBy("b", "a")},
+			cycles: "[[a]]",
+			edges:  "[[a a]]",
+		},
+		{
+			name: "two separate components",
+			tasks: []models.Task{
+				blockedBy("e", "c"), blockedBy("d", "e"), blockedBy("c", "d"),
+				blockedBy("b", "a"), blockedBy("a", "b"),
+				blockedBy("x", "a"),
+			},
+			cycles: "[[a b] [c d e]]",
+			edges:  "[[a b] [b a] [c d] [d e] [e c]]",
+		},
+		{
+			name:   "shortest loop of a component is offered",
+			tasks:  []models.Task{blockedBy("a", "c", "b"), blockedBy("b", "c", "a"), blockedBy("c", "a")},
+			cycles: "[[a b]]",
+			edges:  "[[a b] [b a]]",
+		},
+		{
+			name:   "blockers outside the list are ignored",
+			tasks:  []models.Task{blockedBy("a", "gone"), blockedBy("b", "b", "gone")},
+			cycles: "[[b]]",
+			edges:  "[[b b]]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cycles := findDependencyCycles(tt.tasks)
+			edges := [][2]string{}
+			for _, cycle := range cycles {
+				edges = append(edges, cycle.edges()...)
+			}
+			if got := fmt.Sprint(cycles); got != tt.cycles {
+				t.Errorf("expected cycles %s, got %s", tt.cycles, got)
+			}
+			if got := fmt.Sprint(edges); got != tt.edges {
+				t.Errorf("expected edges %s, got %s", tt.edges, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,440 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+// detailTab is one page of the detail pane
+type detailTab int
+
+const (
+	detailTabOverview detailTab = iota
+	detailTabText
+	detailTabDependencies
+	detailTabHistory
+	detailTabComments
+	detailTabCount
+)
+
+// detailTabBarHeight is the tab bar plus the blank line below it
+const detailTabBarHeight = 2
+
+func (t detailTab) title() string {
+	switch t {
+	case detailTabText:
+		return "Text"
+	case detailTabDependencies:
+		return "Dependencies"
+	case detailTabHistory:
+		return "History"
+	case detailTabComments:
+		return "Comments"
+	}
+	return "Overview"
+}
+
+// commentsLoadedMsg is sent when the comments of an issue have been fetched
+type commentsLoadedMsg struct {
+	taskID   string
+	comments []models.Comment
+	err      error
+}
+
+// setDetailTab shows another tab, keeping the scroll position of each
+func (m *Model) setDetailTab(tab detailTab) tea.Cmd {
+	m.updateDetailContent()
+	m.detailOffsets[m.detailTab] = m.detail.YOffset
+	m.detailTab = tab
+	m.detailLink = -1
+	m.updateDetailContent()
+	m.detail.SetYOffset(m.detailOffsets[tab])
+	return m.loadDetailDataIfNeeded()
+}
+
+// cycleDetailTab moves to the next (1) or previous (-1) tab
+func (m *Model) cycleDetailTab(direction int) tea.Cmd {
+	next := (int(m.detailTab) + direction + int(detailTabCount)) % int(detailTabCount)
+	return m.setDetailTab(detailTab(next))
+}
+
+// scrollDetail scrolls the detail pane by lines, also from list mode
+func (m *Model) scrollDetail(lines int) {
+	m.updateDetailContent()
+	if lines > 0 {
+		m.detail.ScrollDown(lines)
+	} else {
+		m.detail.ScrollUp(-lines)
+	}
+}
+
+// resetDetailScroll starts every tab at the top, e.g. for a newly selected issue
+func (m *Model) resetDetailScroll() {
+	m.detailOffsets = [detailTabCount]int{}
+	m.detail.GotoTop()
+}
+
+// loadDetailDataIfNeeded fetches what the current tab shows beyond the
+// issue itself
+func (m *Model) loadDetailDataIfNeeded() tea.Cmd {
+	return tea.Batch(m.loadCommentsIfNeeded(), m.loadHistoryIfNeeded())
+}
+
+// invalidateDetailData makes the next look at comments, history and file
+// links fetch them again, for an explicit refresh
+func (m *Model) invalidateDetailData() {
+	m.commentsFetched = nil
+	m.historyFetched = nil
+	if m.linkCache != nil {
+		*m.linkCache = taskLinkCache{}
+	}
+}
+
+// markDetailFetch records that data of the selected issue is being fetched
+// for its current version. It reports false if that is already done.
+func (m *Model) markDetailFetch(fetched *map[string]time.Time) bool {
+	id := m.selected.ID
+	updatedAt := m.selected.UpdatedAt
+	if task, ok := m.taskByID(id); ok {
+		updatedAt = task.UpdatedAt
+	}
+	if at, ok := (*fetched)[id]; ok && at.Equal(updatedAt) {
+		return false
+	}
+	if *fetched == nil {
+		*fetched = make(map[string]time.Time)
+	}
+	(*fetched)[id] = updatedAt
+	return true
+}
+
+// loadCommentsIfNeeded fetches the selected issue's comments once the
+// History or Comments tab needs them
+func (m *Model) loadCommentsIfNeeded() tea.Cmd {
+	if m.selected == nil || (m.detailTab != detailTabHistory && m.detailTab != detailTabComments) {
+		return nil
+	}
+	if !m.markDetailFetch(&m.commentsFetched) {
+		return nil
+	}
+	id := m.selected.ID
+
+	client := m.client
+	return func() tea.Msg {
+		comments, err := client.Comments(id)
+		return commentsLoadedMsg{taskID: id, comments: comments, err: err}
+	}
+}
+
+// renderDetailTabs draws the tab bar above the detail pane
+func (m Model) renderDetailTabs(width int) string {
+	var tabs []string
+	for tab := detailTabOverview; tab < detailTabCount; tab++ {
+		name := tab.title()
+		if m.selected != nil {
+			if n := m.detailTabCount(*m.selected, tab); n > 0 {
+				name = fmt.Sprintf("%s %d", name, n)
+			}
+		}
+		if tab == m.detailTab {
+			if ui.NoColor() {
+				name = "[" + name + "]"
+			}
+			tabs = append(tabs, ui.ActiveDetailTabStyle.Render(name))
+		} else {
+			tabs = append(tabs, ui.DetailTabStyle.Render(name))
+		}
+	}
+	bar := strings.Join(tabs, ui.HelpDescStyle.Render(" "+ui.Glyphs.Separator+" "))
+	return lipgloss.NewStyle().MaxWidth(width).Render(bar)
+}
+
+// detailTabCount returns the number shown next to a tab name, or 0
+func (m Model) detailTabCount(t models.Task, tab detailTab) int {
+	switch tab {
+	case detailTabDependencies:
+		n := len(t.BlockedBy) + len(t.Blocks) + len(t.Children)
+		if t.ParentID() != "" {
+			n++
+		}
+		return n
+	case detailTabComments:
+		return len(m.comments[t.ID])
+	}
+	return 0
+}
+
+// renderDetailOverview shows the issue's fields and timestamps
+func (m *Model) renderDetailOverview(b *strings.Builder, t *models.Task) {
+	field := func(label, value string) {
+		b.WriteString(ui.DetailLabelStyle.Render(label))
+		b.WriteString(ui.DetailValueStyle.Render(value))
+		b.WriteString("\n")
+	}
+
+	field("ID:", t.ID)
+	field("Title:", t.Title)
+
+	b.WriteString(ui.DetailLabelStyle.Render("Status:"))
+	b.WriteString(ui.StatusStyle(t.Status).Render(t.Status))
+	b.WriteString("\n")
+
+	b.WriteString(ui.DetailLabelStyle.Render("Priority:"))
+	b.WriteString(ui.PriorityStyle(t.Priority).Render(t.PriorityString()))
+	b.WriteString("\n")
+
+	b.WriteString(ui.DetailLabelStyle.Render("Type:"))
+	b.WriteString(ui.TypeStyle(t.Type).Render(t.Type))
+	b.WriteString("\n")
+	if t.Assignee != "" {
+		field("Assignee:", t.Assignee)
+	}
+	if len(t.Labels) > 0 {
+		field("Labels:", strings.Join(t.Labels, ", "))
+	}
+	if t.DueDate != nil {
+		field("Due:", t.DueDate.Format("2006-01-02"))
+	}
+	if t.IsDeferred(time.Now()) {
+		field("Deferred:", "until "+t.DeferUntil.Format("2006-01-02"))
+	}
+	if t.ParentID() != "" {
+		field("Parent:", t.ParentID())
+	}
+
+	if len(t.Children) > 0 {
+		percent := t.ChildrenClosed * 100 / len(t.Children)
+		b.WriteString(ui.DetailLabelStyle.Render("Progress:"))
+		b.WriteString(renderProgressBar(t.ChildrenClosed, len(t.Children), progressBarWidth))
+		b.WriteString(ui.DetailValueStyle.Render(fmt.Sprintf(" %s (%d%%)", epicProgressText(*t), percent)))
+		b.WriteString("\n")
+	}
+
+	if t.ChecklistTotal > 0 {
+		b.WriteString(ui.DetailLabelStyle.Render("Checklist:"))
+		b.WriteString(renderProgressBar(t.ChecklistDone, t.ChecklistTotal, progressBarWidth))
+		b.WriteString(ui.DetailValueStyle.Render(" " + checklistProgressText(*t)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(ui.DetailLabelStyle.Render("Created:"))
+	b.WriteString(ui.DetailValueStyle.Render(t.CreatedAt.Format("2006-01-02 15:04")))
+	if t.CreatedBy != "" {
+		b.WriteString(ui.HelpDescStyle.Render(" by " + t.CreatedBy))
+	}
+	b.WriteString("\n")
+
+	field("Updated:", t.UpdatedAt.Format("2006-01-02 15:04"))
+	if t.ClosedAt != nil {
+		field("Closed:", t.ClosedAt.Format("2006-01-02 15:04"))
+	}
+}
+
+// renderDetailText shows the long text fields
+func (m *Model) renderDetailText(b *strings.Builder, t *models.Task) {
+	width := maxInt(m.detail.Width-2, 20)
+	wrote := false
+	section := func(label, value string, markdown bool) {
+		if value == "" {
+			return
+		}
+		if wrote {
+			b.WriteString("\n")
+		}
+		wrote = true
+		b.WriteString(ui.DetailLabelStyle.Render(label))
+		b.WriteString("\n")
+		if markdown && !m.rawMarkdown {
+			b.WriteString(ui.RenderMarkdown(value, width))
+		} else {
+			b.WriteString(lipgloss.NewStyle().Width(width).Render(value))
+		}
+		b.WriteString("\n")
+	}
+
+	section("Description:", t.Description, true)
+	section("Notes:", t.Notes, true)
+	section("Design:", t.Design, true)
+	section("Acceptance Criteria:", t.AcceptanceCriteria, true)
+	section("Close Reason:", t.CloseReason, false)
+
+	if !wrote {
+		b.WriteString(ui.HelpDescStyle.Render("No description, notes or design"))
+		b.WriteString("\n")
+	}
+}
+
+// renderDetailDependencies lists the parent, blockers, blocked issues and
+// children with their status
+func (m *Model) renderDetailDependencies(b *strings.Builder, t *models.Task) {
+	tasksByID := make(map[string]models.Task, len(m.tasks))
+	for _, task := range m.tasks {
+		tasksByID[task.ID] = task
+	}
+	wrote := false
+	section := func(label string, ids []string) {
+		if len(ids) == 0 {
+			return
+		}
+		if wrote {
+			b.WriteString("\n")
+		}
+		wrote = true
+		b.WriteString(ui.DetailLabelStyle.Render(label))
+		b.WriteString("\n")
+		for _, id := range ids {
+			task, ok := tasksByID[id]
+			if !ok {
+				b.WriteString("  - " + id + "\n")
+				continue
+			}
+			status := ui.StatusStyle(task.Status).Render(ui.StatusIcon(task.Status))
+			if ui.NoColor() {
+				status += " " + task.Status
+			}
+			b.WriteString(fmt.Sprintf("  %s %s %s\n", status, id, task.Title))
+		}
+	}
+
+	if parent := t.ParentID(); parent != "" {
+		section("Parent:", []string{parent})
+	}
+	section("Blocked by:", t.BlockedBy)
+	section("Blocks:", t.Blocks)
+	section("Children:", t.Children)
+
+	if !wrote {
+		b.WriteString(ui.HelpDescStyle.Render("No dependencies"))
+		b.WriteString("\n")
+	}
+}
+
+// historyEvent is one entry in the History tab
+type historyEvent struct {
+	at    time.Time
+	actor string
+	text  string
+	diffs []fieldDiff
+}
+
+// taskHistory builds a timeline, oldest first. The audit trail is used when
+// bd recorded one; otherwise the timeline is derived from the issue's
+// timestamps and comments.
+func taskHistory(t models.Task, comments []models.Comment, audit []models.Event) []historyEvent {
+	var events []historyEvent
+	if len(audit) > 0 {
+		commented := false
+		for _, e := range audit {
+			text, diffs := describeEvent(e)
+			events = append(events, historyEvent{at: e.CreatedAt, actor: e.Actor, text: text, diffs: diffs})
+			commented = commented || e.Type == "commented"
+		}
+		if !commented {
+			for _, c := range comments {
+				events = append(events, historyEvent{at: c.CreatedAt, actor: c.Author, text: "Commented"})
+			}
+		}
+		sort.SliceStable(events, func(i, j int) bool { return events[i].at.Before(events[j].at) })
+		return events
+	}
+
+	created := "Created"
+	if t.CreatedBy != "" {
+		created += " by " + t.CreatedBy
+	}
+	events = append(events, historyEvent{at: t.CreatedAt, text: created})
+
+	for _, c := range comments {
+		author := c.Author
+		if author == "" {
+			author = "someone"
+		}
+		events = append(events, historyEvent{at: c.CreatedAt, text: "Comment by " + author})
+	}
+
+	if t.ClosedAt != nil {
+		closed := "Closed"
+		if t.CloseReason != "" {
+			closed += ": " + t.CloseReason
+		}
+		events = append(events, historyEvent{at: *t.ClosedAt, text: closed})
+	}
+	if !t.UpdatedAt.IsZero() && !t.UpdatedAt.Equal(t.CreatedAt) && (t.ClosedAt == nil || !t.UpdatedAt.Equal(*t.ClosedAt)) {
+		events = append(events, historyEvent{at: t.UpdatedAt, text: "Last updated"})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].at.Before(events[j].at) })
+	return events
+}
+
+// renderDetailHistory shows the issue's timeline
+func (m *Model) renderDetailHistory(b *strings.Builder, t *models.Task) {
+	for _, event := range taskHistory(*t, m.comments[t.ID], m.history[t.ID]) {
+		b.WriteString(ui.HelpDescStyle.Render(event.at.Local().Format("2006-01-02 15:04")))
+		if event.actor != "" {
+			b.WriteString("  " + ui.DetailValueStyle.Bold(true).Render(event.actor))
+		}
+		b.WriteString("  " + event.text + "\n")
+		for _, d := range event.diffs {
+			b.WriteString(renderFieldDiff(d) + "\n")
+		}
+	}
+	if err := m.historyErr[t.ID]; err != nil {
+		b.WriteString(ui.ErrorStyle.Render("Failed to load history: " + err.Error()))
+		b.WriteString("\n")
+	} else if _, loaded := m.history[t.ID]; !loaded {
+		b.WriteString(ui.HelpDescStyle.Render("Loading history" + ui.Glyphs.Ellipsis))
+		b.WriteString("\n")
+	}
+	m.renderCommentsStatus(b, t.ID)
+}
+
+// renderDetailComments shows the issue's comments, oldest first
+func (m *Model) renderDetailComments(b *strings.Builder, t *models.Task) {
+	width := maxInt(m.detail.Width-2, 20)
+	for i, c := range m.comments[t.ID] {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		author := c.Author
+		if author == "" {
+			author = "unknown"
+		}
+		b.WriteString(ui.DetailValueStyle.Bold(true).Render(author))
+		b.WriteString(ui.HelpDescStyle.Render(" " + ui.Glyphs.Dot + " " + c.CreatedAt.Local().Format("2006-01-02 15:04")))
+		b.WriteString("\n")
+		if m.rawMarkdown {
+			b.WriteString(lipgloss.NewStyle().Width(width).Render(c.Text))
+		} else {
+			b.WriteString(ui.RenderMarkdown(c.Text, width))
+		}
+		b.WriteString("\n")
+	}
+	if comments, loaded := m.comments[t.ID]; loaded && len(comments) == 0 && m.commentsErr[t.ID] == nil {
+		b.WriteString(ui.HelpDescStyle.Render("No comments"))
+		b.WriteString("\n")
+	}
+	m.renderCommentsStatus(b, t.ID)
+}
+
+// renderCommentsStatus notes comments that are still loading or failed to load
+func (m *Model) renderCommentsStatus(b *strings.Builder, id string) {
+	if err := m.commentsErr[id]; err != nil {
+		b.WriteString(ui.ErrorStyle.Render("Failed to load comments: " + err.Error()))
+		b.WriteString("\n")
+		return
+	}
+	if _, loaded := m.comments[id]; !loaded {
+		b.WriteString(ui.HelpDescStyle.Render("Loading comments" + ui.Glyphs.Ellipsis))
+		b.WriteString("\n")
+	}
+}
@@ -0,0 +1,122 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"lazybeads/internal/models"
+)
+
+func TestTaskHistory(t *testing.T) {
+	created := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	closed := created.Add(72 * time.Hour)
+	task := models.Task{
+		CreatedAt:   created,
+		CreatedBy:   "alice",
+		UpdatedAt:   closed,
+		ClosedAt:    &closed,
+		CloseReason: "shipped",
+	}
+	comments := []models.Comment{{Author: "bob", CreatedAt: created.Add(time.Hour)}}
+
+	var got []string
+	for _, event := range taskHistory(task, comments, nil) {
+		got = append(got, event.text)
+	}
+	expected := "Created by alice|Comment by bob|Closed: shipped"
+	if strings.Join(got, "|") != expected {
+		t.Errorf("expected %s, got %s", expected, strings.Join(got, "|"))
+	}
+}
+
+func TestDetailTabsKeepScrollPosition(t *testing.T) {
+	m := newTestModel(t, models.Task{ID: "lazybeads-1", Title: "Long", Status: "open", Description: strings.Repeat("line\n\n", 60)})
+	m.selected = m.getSelectedTask()
+
+	m.setDetailTab(detailTabText)
+	m.scrollDetail(5)
+	if m.detail.YOffset != 5 {
+		t.Fatalf("expected text tab scrolled to 5, got %d", m.detail.YOffset)
+	}
+
+	m.cycleDetailTab(1)
+	if m.detailTab != detailTabDependencies || m.detail.YOffset != 0 {
+		t.Errorf("expected dependencies tab at the top, got tab %d offset %d", m.detailTab, m.detail.YOffset)
+	}
+
+	m.cycleDetailTab(-1)
+	if m.detailTab != detailTabText || m.detail.YOffset != 5 {
+		t.Errorf("expected text tab back at offset 5, got tab %d offset %d", m.detailTab, m.detail.YOffset)
+	}
+}
+
+func TestTaskHistoryFromEvents(t *testing.T) {
+	at := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	str := func(s string) *string { return &s }
+	events := []models.Event{
+		{Type: "created", Actor: "alice", CreatedAt: at},
+		{Type: "status_changed", Actor: "bob", OldValue: str("open"), NewValue: str("in_progress"), CreatedAt: at.Add(time.Hour)},
+		{
+			Type:      "updated",
+			Actor:     "carol",
+			OldValue:  str(`{"priority":2,"title":"Old","status":"open"}`),
+			NewValue:  str(`{"priority":1,"title":"Old","assignee":"dave"}`),
+			CreatedAt: at.Add(2 * time.Hour),
+		},
+	}
+	comments := []models.Comment{{Author: "bob", CreatedAt: at.Add(30 * time.Minute)}}
+
+	history := taskHistory(models.Task{CreatedAt: at}, comments, events)
+	var got []string
+	for _, event := range history {
+		line := event.actor + " " + event.text
+		for _, d := range event.diffs {
+			line += fmt.Sprintf(" [%s: %s → %s]", d.field, d.old, d.new)
+		}
+		got = append(got, line)
+	}
+
+	expected := []string{
+		"alice Created",
+		"bob Commented",
+		"bob Changed status [status: open → in_progress]",
+		"carol Updated [assignee:  → dave] [priority: 2 → 1]",
+	}
+	if strings.Join(got, "|") != strings.Join(expected, "|") {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestCommentsRefetchedOnlyWhenIssueChanges(t *testing.T) {
+	updatedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	task := models.Task{ID: "lazybeads-1", Title: "Discussed", Status: "open", UpdatedAt: updatedAt}
+	m := newTestModel(t, task)
+	m.selected = m.getSelectedTask()
+	m.detailTab = detailTabComments
+
+	if m.loadCommentsIfNeeded() == nil {
+		t.Fatal("expected the first look at comments to fetch them")
+	}
+	reload := func(task models.Task) {
+		updated, _ := m.Update(tasksLoadedMsg{tasks: []models.Task{task}})
+		m = updated.(Model)
+	}
+
+	reload(task)
+	if m.loadCommentsIfNeeded() != nil {
+		t.Error("expected a poll of the unchanged issue not to fetch comments again")
+	}
+
+	task.UpdatedAt = updatedAt.Add(time.Minute)
+	reload(task)
+	if at := m.commentsFetched[task.ID]; !at.Equal(task.UpdatedAt) {
+		t.Errorf("expected comments fetched again for the updated issue, last fetch was for %v", at)
+	}
+
+	m.invalidateDetailData()
+	if m.loadCommentsIfNeeded() == nil {
+		t.Error("expected an explicit refresh to fetch comments again")
+	}
+}
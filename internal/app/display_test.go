@@ -0,0 +1,39 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+func TestPlainDisplayShowsStateAsText(t *testing.T) {
+	defer ui.SetDisplay(ui.Display{})
+	ui.SetDisplay(ui.Display{ASCII: true, NoColor: true})
+
+	task := models.Task{
+		ID:        "lazybeads-7",
+		Title:     "Blocked work",
+		Priority:  1,
+		BlockedBy: []string{"lazybeads-3"},
+		Children:  []string{"lazybeads-8"},
+	}
+	line := formatTaskLine(task, 60, true, true)
+	if !strings.HasPrefix(line, ">!  P1 7 + Blocked work") {
+		t.Errorf("expected selection, blocked marker, priority and expander as text, got %q", line)
+	}
+	if other := formatTaskLine(task, 60, false, true); !strings.HasPrefix(other, " !") {
+		t.Errorf("expected unselected rows without the selection marker, got %q", other)
+	}
+	for _, r := range line {
+		if r > 127 {
+			t.Fatalf("expected ASCII only, got %q", line)
+		}
+	}
+
+	m := Model{selected: &task, detailTab: detailTabText}
+	if tabs := m.renderDetailTabs(80); !strings.Contains(tabs, "[Text]") || !strings.Contains(tabs, "Overview | ") {
+		t.Errorf("expected the active tab in brackets and ASCII separators, got %q", tabs)
+	}
+}
@@ -0,0 +1,169 @@
+package app
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/drafts"
+	"lazybeads/internal/ui"
+)
+
+// currentFormDraft captures every form field as a draft
+func (m *Model) currentFormDraft() drafts.FormDraft {
+	draft := drafts.FormDraft{
+		LinkKind:           string(m.formLink.kind),
+		LinkTarget:         m.formLink.targetID,
+		Title:              m.formTitle.Value(),
+		Description:        m.formDesc.Value(),
+		Notes:              m.formNotes.Value(),
+		Design:             m.formDesign.Value(),
+		AcceptanceCriteria: m.formAcceptance.Value(),
+		Priority:           m.formPriority,
+		Type:               m.formType,
+		Labels:             m.formLabels.Value(),
+		Assignee:           m.formAssignee.Value(),
+		Due:                m.formDue.Value(),
+		Defer:              m.formDefer.Value(),
+	}
+	if m.editing {
+		original := m.editingOriginal
+		draft.EditingID = m.editingID
+		draft.Original = &original
+	}
+	return draft
+}
+
+// sameFormDraft compares drafts ignoring the original snapshot and save time
+func sameFormDraft(a, b drafts.FormDraft) bool {
+	a.Original, b.Original = nil, nil
+	return a == b
+}
+
+// markFormOpened notes the values the form opened with, so a form left as
+// it was opened is not kept as a draft
+func (m *Model) markFormOpened() {
+	m.formOpened = m.currentFormDraft()
+}
+
+// saveFormDraft persists the form whenever its contents change, once they
+// differ from the values it opened with
+func (m *Model) saveFormDraft() {
+	if m.draftStore == nil {
+		return
+	}
+	draft := m.currentFormDraft()
+	if sameFormDraft(draft, m.savedFormDraft) {
+		return
+	}
+	if sameFormDraft(draft, m.formOpened) {
+		// Changed back: drop the draft saved on the way, if any
+		if !sameFormDraft(m.savedFormDraft, drafts.FormDraft{}) {
+			m.clearFormDraft()
+		}
+		return
+	}
+	if err := m.draftStore.SaveForm(draft); err != nil {
+		m.err = fmt.Errorf("failed to save draft: %w", err)
+		return
+	}
+	m.savedFormDraft = draft
+}
+
+// clearFormDraft removes the saved form once it is submitted or cancelled
+func (m *Model) clearFormDraft() {
+	m.savedFormDraft = drafts.FormDraft{}
+	if m.draftStore == nil {
+		return
+	}
+	if err := m.draftStore.ClearForm(); err != nil {
+		m.err = fmt.Errorf("failed to remove draft: %w", err)
+	}
+}
+
+// applyFormDraft restores the form from a draft
+func (m *Model) applyFormDraft(draft drafts.FormDraft) {
+	m.resetForm()
+	m.editing = draft.EditingID != ""
+	m.editingID = draft.EditingID
+	if draft.Original != nil {
+		m.editingOriginal = *draft.Original
+	}
+	m.formLink = formLink{kind: linkKind(draft.LinkKind), targetID: draft.LinkTarget}
+
+	m.setFormTitle(draft.Title)
+	m.setFormText(&m.formDesc, "Description", draft.Description)
+	m.setFormText(&m.formNotes, "Notes", draft.Notes)
+	m.setFormText(&m.formDesign, "Design", draft.Design)
+	m.setFormText(&m.formAcceptance, "Acceptance criteria", draft.AcceptanceCriteria)
+	m.formPriority = draft.Priority
+	m.formType = draft.Type
+	m.formLabels.SetValue(draft.Labels)
+	m.formAssignee.SetValue(draft.Assignee)
+	m.formDue.SetValue(draft.Due)
+	m.formDefer.SetValue(draft.Defer)
+	m.savedFormDraft = draft
+	// A restored draft is kept even if left unchanged
+	m.formOpened = drafts.FormDraft{}
+
+	m.mode = ViewForm
+	m.formFocus = 0
+	m.updateFormFocus()
+	m.updateFormTextAreaHeights()
+}
+
+// openRestoreDraftModal asks whether to restore a form left unsaved
+func (m *Model) openRestoreDraftModal(draft *drafts.FormDraft) {
+	subject := draft.Title
+	if subject == "" {
+		subject = "untitled"
+	}
+	if draft.EditingID != "" {
+		subject = "edit of " + draft.EditingID + ": " + subject
+	}
+	subtitle := fmt.Sprintf("%s (saved %s)", subject, draft.SavedAt.Local().Format("2006-01-02 15:04"))
+
+	options := []ui.ModalOption{
+		{Label: "Restore draft", Value: "restore", Shortcut: "r"},
+		{Label: "Discard draft", Value: "discard", Shortcut: "d"},
+	}
+	m.modal = ui.NewModalSelect("Restore Unsaved Draft?", subtitle, options, "")
+	m.pendingDraft = draft
+	m.mode = ViewRestoreDraft
+}
+
+func (m *Model) handleRestoreDraftKeys(msg tea.KeyMsg) tea.Cmd {
+	keyStr := msg.String()
+	if !m.modal.SelectByShortcut(keyStr) {
+		switch keyStr {
+		case "k", "up":
+			m.modal.MoveUp()
+			return nil
+		case "j", "down":
+			m.modal.MoveDown()
+			return nil
+		case "esc":
+			// Keep the draft and ask again next time
+			m.pendingDraft = nil
+			m.mode = ViewList
+			return nil
+		case "enter":
+		default:
+			return nil
+		}
+	}
+
+	draft := m.pendingDraft
+	m.pendingDraft = nil
+	if draft == nil {
+		m.mode = ViewList
+		return nil
+	}
+	if m.modal.SelectedValue() == "restore" {
+		m.applyFormDraft(*draft)
+		return nil
+	}
+	m.clearFormDraft()
+	m.mode = ViewList
+	return nil
+}
@@ -0,0 +1,43 @@
+package app
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestFormDraftKeptOnlyForChangedForms(t *testing.T) {
+	m := newTestModel(t)
+	update := func(msg tea.Msg) {
+		updated, _ := m.Update(msg)
+		m = updated.(Model)
+	}
+	savedDraft := func() bool {
+		draft, err := m.draftStore.LoadForm()
+		if err != nil {
+			t.Fatalf("LoadForm failed: %v", err)
+		}
+		return draft != nil
+	}
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if m.mode != ViewForm {
+		t.Fatalf("expected the create form, got mode %v", m.mode)
+	}
+	update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	update(tea.KeyMsg{Type: tea.KeyTab})
+	if savedDraft() {
+		t.Fatal("expected no draft for a form left as opened")
+	}
+
+	update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("hello")})
+	if !savedDraft() {
+		t.Fatal("expected a draft once the form was typed in")
+	}
+
+	update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.mode != ViewList || savedDraft() {
+		t.Errorf("expected esc to close the form and discard its draft, got mode %v", m.mode)
+	}
+}
@@ -0,0 +1,183 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"lazybeads/internal/beads"
+	"lazybeads/internal/models"
+)
+
+const formDateLayout = "2006-01-02"
+
+// formValues is a parsed snapshot of every field in the create/edit form
+type formValues struct {
+	Title              string
+	Description        string
+	Notes              string
+	Design             string
+	AcceptanceCriteria string
+	Priority           int
+	Type               string
+	Labels             []string
+	Assignee           string
+	Due                *time.Time
+	Defer              *time.Time
+}
+
+// readFormValues validates the form and parses labels and dates
+func (m *Model) readFormValues(now time.Time) (formValues, error) {
+	values := formValues{
+		Title:              strings.TrimSpace(m.formTitle.Value()),
+		Description:        m.formDesc.Value(),
+		Notes:              m.formNotes.Value(),
+		Design:             m.formDesign.Value(),
+		AcceptanceCriteria: m.formAcceptance.Value(),
+		Priority:           m.formPriority,
+		Type:               m.formType,
+		Labels:             splitLabels(m.formLabels.Value()),
+		Assignee:           strings.TrimSpace(m.formAssignee.Value()),
+	}
+	if values.Title == "" {
+		return values, fmt.Errorf("title is required")
+	}
+
+	var err error
+	if values.Due, err = parseFormDate(m.formDue.Value(), now); err != nil {
+		return values, fmt.Errorf("due: %w", err)
+	}
+	if values.Defer, err = parseFormDate(m.formDefer.Value(), now); err != nil {
+		return values, fmt.Errorf("defer: %w", err)
+	}
+	return values, nil
+}
+
+// parseFormDate parses an optional date field; empty means no date
+func parseFormDate(value string, now time.Time) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	date, err := parseCaptureDate(value, now)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
+// formatFormDate renders an optional date for a form field
+func formatFormDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Local().Format(formDateLayout)
+}
+
+// splitLabels parses a comma-separated label list, dropping blanks and duplicates
+func splitLabels(value string) []string {
+	var labels []string
+	for _, label := range strings.Split(value, ",") {
+		label = strings.TrimSpace(label)
+		if label != "" && !containsString(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// openEditForm opens the form with every field of task prefilled
+func (m *Model) openEditForm(task *models.Task) {
+	m.resetForm()
+	m.editing = true
+	m.editingID = task.ID
+	m.editingOriginal = *task
+
+	m.setFormTitle(task.Title)
+	m.setFormText(&m.formDesc, "Description", task.Description)
+	m.setFormText(&m.formNotes, "Notes", task.Notes)
+	m.setFormText(&m.formDesign, "Design", task.Design)
+	m.setFormText(&m.formAcceptance, "Acceptance criteria", task.AcceptanceCriteria)
+	m.formPriority = task.Priority
+	m.formType = task.Type
+	m.formLabels.SetValue(strings.Join(task.Labels, ", "))
+	m.formAssignee.SetValue(task.Assignee)
+	m.formDue.SetValue(formatFormDate(task.DueDate))
+	m.formDefer.SetValue(formatFormDate(task.DeferUntil))
+	m.markFormOpened()
+
+	m.mode = ViewForm
+	m.formFocus = 0
+	m.updateFormFocus()
+	m.updateFormTextAreaHeights()
+}
+
+// diffTaskUpdate returns update options containing only the fields that
+// differ from orig, plus the names of the changed fields.
+func diffTaskUpdate(orig models.Task, v formValues) (beads.UpdateOptions, []string) {
+	var opts beads.UpdateOptions
+	var changes []string
+
+	if v.Title != orig.Title {
+		opts.Title = v.Title
+		changes = append(changes, "title")
+	}
+
+	text := func(name, flag, old, value string, set *string) {
+		if value == old {
+			return
+		}
+		if value == "" {
+			opts.Clear = append(opts.Clear, flag)
+		} else {
+			*set = value
+		}
+		changes = append(changes, name)
+	}
+	text("description", "description", orig.Description, v.Description, &opts.Description)
+	text("notes", "notes", orig.Notes, v.Notes, &opts.Notes)
+	text("design", "design", orig.Design, v.Design, &opts.Design)
+	text("acceptance criteria", "acceptance", orig.AcceptanceCriteria, v.AcceptanceCriteria, &opts.AcceptanceCriteria)
+
+	if v.Priority != orig.Priority {
+		priority := v.Priority
+		opts.Priority = &priority
+		changes = append(changes, "priority")
+	}
+	if v.Type != orig.Type {
+		opts.Type = v.Type
+		changes = append(changes, "type")
+	}
+
+	for _, label := range v.Labels {
+		if !containsString(orig.Labels, label) {
+			opts.AddLabels = append(opts.AddLabels, label)
+		}
+	}
+	for _, label := range orig.Labels {
+		if !containsString(v.Labels, label) {
+			opts.RemoveLabels = append(opts.RemoveLabels, label)
+		}
+	}
+	if len(opts.AddLabels) > 0 || len(opts.RemoveLabels) > 0 {
+		changes = append(changes, "labels")
+	}
+
+	text("assignee", "assignee", orig.Assignee, v.Assignee, &opts.Assignee)
+
+	date := func(name string, old, value *time.Time, set **time.Time) {
+		if formatFormDate(old) == formatFormDate(value) {
+			return
+		}
+		if value == nil {
+			opts.Clear = append(opts.Clear, name)
+		} else {
+			*set = value
+		}
+		changes = append(changes, name)
+	}
+	date("due", orig.DueDate, v.Due, &opts.Due)
+	date("defer", orig.DeferUntil, v.Defer, &opts.Defer)
+
+	return opts, changes
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"lazybeads/internal/models"
+)
+
+func TestDiffTaskUpdate(t *testing.T) {
+	due := time.Date(2026, 11, 2, 0, 0, 0, 0, time.Local)
+	orig := models.Task{
+		ID:          "lazybeads-1",
+		Title:       "Old title",
+		Description: "Body",
+		Notes:       "Some notes",
+		Priority:    2,
+		Type:        "task",
+		Labels:      []string{"ui", "backend"},
+		Assignee:    "alice",
+		DueDate:     &due,
+	}
+
+	values := formValues{
+		Title:       "New title",
+		Description: "Body",
+		Notes:       "",
+		Priority:    1,
+		Type:        "task",
+		Labels:      []string{"ui", "docs"},
+		Assignee:    "alice",
+	}
+
+	opts, changes := diffTaskUpdate(orig, values)
+
+	wantChanges := []string{"title", "notes", "priority", "labels", "due"}
+	if !reflect.DeepEqual(changes, wantChanges) {
+		t.Errorf("expected changes %v, got %v", wantChanges, changes)
+	}
+	if opts.Title != "New title" {
+		t.Errorf("expected title 'New title', got '%s'", opts.Title)
+	}
+	if opts.Description != "" || opts.Assignee != "" || opts.Type != "" {
+		t.Errorf("unchanged fields should not be sent: %+v", opts)
+	}
+	if opts.Priority == nil || *opts.Priority != 1 {
+		t.Errorf("expected priority 1, got %v", opts.Priority)
+	}
+	if !reflect.DeepEqual(opts.AddLabels, []string{"docs"}) || !reflect.DeepEqual(opts.RemoveLabels, []string{"backend"}) {
+		t.Errorf("expected +docs -backend, got +%v -%v", opts.AddLabels, opts.RemoveLabels)
+	}
+	if !reflect.DeepEqual(opts.Clear, []string{"notes", "due"}) {
+		t.Errorf("expected notes and due to be cleared, got %v", opts.Clear)
+	}
+}
+
+func TestDiffTaskUpdateNoChanges(t *testing.T) {
+	due := time.Date(2026, 11, 2, 15, 30, 0, 0, time.Local)
+	orig := models.Task{Title: "Same", Priority: 3, Type: "bug", Labels: []string{"a"}, DueDate: &due}
+	sameDay := time.Date(2026, 11, 2, 0, 0, 0, 0, time.Local)
+
+	_, changes := diffTaskUpdate(orig, formValues{Title: "Same", Priority: 3, Type: "bug", Labels: []string{"a"}, Due: &sameDay})
+	if len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestSplitLabels(t *testing.T) {
+	got := splitLabels(" ui, backend,,ui , docs ")
+	want := []string{"ui", "backend", "docs"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if splitLabels("  ") != nil {
+		t.Errorf("expected nil for blank input")
+	}
+}
@@ -0,0 +1,189 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+const (
+	progressBarWidth    = 10
+	rowProgressBarWidth = 5
+)
+
+// applyEpicChildren links every task with a parent to that parent's child list
+// and counts how many children are closed.
+func applyEpicChildren(tasks []models.Task) {
+	index := make(map[string]int, len(tasks))
+	for i := range tasks {
+		tasks[i].Children = nil
+		tasks[i].ChildrenClosed = 0
+		index[tasks[i].ID] = i
+	}
+
+	for _, task := range tasks {
+		parentID := task.ParentID()
+		if parentID == "" || parentID == task.ID {
+			continue
+		}
+		idx, ok := index[parentID]
+		if !ok {
+			continue
+		}
+		tasks[idx].Children = append(tasks[idx].Children, task.ID)
+		if task.Status == "closed" {
+			tasks[idx].ChildrenClosed++
+		}
+	}
+
+	for i := range tasks {
+		sort.Strings(tasks[i].Children)
+	}
+}
+
+// nestEpicChildren inserts the children of expanded epics directly below them
+// in each panel list. Children shown under an epic are removed from the top
+// level of every list, so each issue is listed in one place only. Issues whose
+// parents form a loop are listed at the top level rather than only under each
+// other, where no row would show them.
+func nestEpicChildren(lists [][]models.Task, all map[string]models.Task, expanded map[string]bool) [][]models.Task {
+	if len(expanded) == 0 {
+		return lists
+	}
+
+	nested := make(map[string]bool)
+	var markNested func(id string)
+	markNested = func(id string) {
+		for _, childID := range all[id].Children {
+			if nested[childID] {
+				continue
+			}
+			nested[childID] = true
+			if expanded[childID] {
+				markNested(childID)
+			}
+		}
+	}
+	for _, tasks := range lists {
+		for _, task := range tasks {
+			if expanded[task.ID] {
+				markNested(task.ID)
+			}
+		}
+	}
+
+	results := make([][]models.Task, len(lists))
+	shown := make(map[string]bool)
+	visited := make(map[string]bool)
+	var appendChildren func(result []models.Task, parent models.Task, indent string) []models.Task
+	appendChildren = func(result []models.Task, parent models.Task, indent string) []models.Task {
+		if !parent.Expanded || visited[parent.ID] {
+			return result
+		}
+		visited[parent.ID] = true
+		for i, childID := range parent.Children {
+			child, ok := all[childID]
+			if !ok || shown[childID] {
+				continue
+			}
+			branch := ui.Glyphs.TreeBranch
+			next := ui.Glyphs.TreePipe
+			if i == len(parent.Children)-1 {
+				branch = ui.Glyphs.TreeLast
+				next = "   "
+			}
+			child.TreePrefix = indent + branch
+			child.Expanded = expanded[child.ID]
+			result = append(result, child)
+			shown[child.ID] = true
+			result = appendChildren(result, child, indent+next)
+		}
+		return result
+	}
+	appendTopLevel := func(result []models.Task, task models.Task) []models.Task {
+		task.Expanded = expanded[task.ID]
+		result = append(result, task)
+		shown[task.ID] = true
+		return appendChildren(result, task, strings.Repeat(" ", lipgloss.Width(task.TreePrefix)))
+	}
+
+	for i, tasks := range lists {
+		results[i] = make([]models.Task, 0, len(tasks))
+		for _, task := range tasks {
+			if !nested[task.ID] {
+				results[i] = appendTopLevel(results[i], task)
+			}
+		}
+	}
+	for i, tasks := range lists {
+		for _, task := range tasks {
+			if !shown[task.ID] {
+				results[i] = appendTopLevel(results[i], task)
+			}
+		}
+	}
+	return results
+}
+
+// progressCells returns how many of width cells are filled for done out of total.
+func progressCells(done, total, width int) int {
+	if total <= 0 || width <= 0 {
+		return 0
+	}
+	filled := done * width / total
+	if done > 0 && filled == 0 {
+		filled = 1
+	}
+	return filled
+}
+
+// renderProgressBar draws a coloured fixed-width bar for done out of total.
+func renderProgressBar(done, total, width int) string {
+	if total <= 0 || width <= 0 {
+		return ""
+	}
+	filled := progressCells(done, total, width)
+	return lipgloss.NewStyle().Foreground(ui.ColorPrimary).Render(strings.Repeat(ui.Glyphs.BarFull, filled)) +
+		lipgloss.NewStyle().Foreground(ui.ColorMuted).Render(strings.Repeat(ui.Glyphs.BarEmpty, width-filled))
+}
+
+// epicProgressText returns "done/total" for tasks that have children.
+func epicProgressText(task models.Task) string {
+	if len(task.Children) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", task.ChildrenClosed, len(task.Children))
+}
+
+// epicRowProgress returns a compact unstyled bar and count for list rows.
+func epicRowProgress(task models.Task) string {
+	if len(task.Children) == 0 {
+		return ""
+	}
+	filled := progressCells(task.ChildrenClosed, len(task.Children), rowProgressBarWidth)
+	bar := strings.Repeat(ui.Glyphs.BarFull, filled) + strings.Repeat(ui.Glyphs.BarEmpty, rowProgressBarWidth-filled)
+	return bar + " " + epicProgressText(task)
+}
+
+// toggleEpic expands or collapses the selected epic in place.
+func (m *Model) toggleEpic(task *models.Task) tea.Cmd {
+	if len(task.Children) == 0 {
+		return m.flashStatus("No child issues")
+	}
+	if m.expandedEpics == nil {
+		m.expandedEpics = make(map[string]bool)
+	}
+	if m.expandedEpics[task.ID] {
+		delete(m.expandedEpics, task.ID)
+	} else {
+		m.expandedEpics[task.ID] = true
+	}
+	m.distributeTasks()
+	return nil
+}
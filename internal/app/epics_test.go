@@ -0,0 +1,167 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"lazybeads/internal/models"
+)
+
+func TestApplyEpicChildren(t *testing.T) {
+	tests := []struct {
+		name           string
+		tasks          []models.Task
+		parent         string
+		children       []string
+		childrenClosed int
+	}{
+		{
+			name: "children sorted and closed ones counted",
+			tasks: []models.Task{
+				{ID: "e", Type: "epic", Status: "open"},
+				{ID: "c2", Parent: "e", Status: "closed"},
+				{ID: "c1", Parent: "e", Status: "in_progress"},
+			},
+			parent:         "e",
+			children:       []string{"c1", "c2"},
+			childrenClosed: 1,
+		},
+		{
+			name: "parent from a parent-child dependency",
+			tasks: []models.Task{
+				{ID: "e", Type: "epic", Status: "open"},
+				{ID: "c1", Status: "open", Dependencies: []models.Dependency{{DependsOnID: "e", Type: models.DependencyParentChild}}},
+				{ID: "c2", Status: "open", Dependencies: []models.Dependency{{DependsOnID: "e", Type: models.DependencyBlocks}}},
+			},
+			parent:   "e",
+			children: []string{"c1"},
+		},
+		{
+			name: "nested epic is a child of its parent only",
+			tasks: []models.Task{
+				{ID: "e", Type: "epic"},
+				{ID: "sub", Type: "epic", Parent: "e"},
+				{ID: "leaf", Parent: "sub", Status: "closed"},
+			},
+			parent:   "e",
+			children: []string{"sub"},
+		},
+		{
+			name: "self parent and unknown parent ignored",
+			tasks: []models.Task{
+				{ID: "e", Type: "epic", Parent: "e"},
+				{ID: "c1", Parent: "missing"},
+			},
+			parent: "e",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks := append([]models.Task(nil), tt.tasks...)
+			applyEpicChildren(tasks)
+			for _, task := range tasks {
+				if task.ID != tt.parent {
+					continue
+				}
+				if strings.Join(task.Children, ",") != strings.Join(tt.children, ",") || task.ChildrenClosed != tt.childrenClosed {
+					t.Errorf("expected children %v with %d closed, got %v with %d", tt.children, tt.childrenClosed, task.Children, task.ChildrenClosed)
+				}
+			}
+		})
+	}
+}
+
+func TestNestEpicChildren(t *testing.T) {
+	tests := []struct {
+		name     string
+		tasks    []models.Task
+		lists    [][]string // IDs in each panel before nesting
+		expanded []string
+		want     [][]string // ID and tree prefix of each row
+	}{
+		{
+			name: "collapsed epic keeps children at the top level",
+			tasks: []models.Task{
+				{ID: "e", Type: "epic", Status: "open"},
+				{ID: "c1", Parent: "e", Status: "open"},
+			},
+			lists: [][]string{{"e", "c1"}},
+			want:  [][]string{{"e", "c1"}},
+		},
+		{
+			name: "nested expanded epics indent their children",
+			tasks: []models.Task{
+				{ID: "e", Type: "epic", Status: "open"},
+				{ID: "sub", Type: "epic", Parent: "e", Status: "open"},
+				{ID: "leaf", Parent: "sub", Status: "open"},
+				{ID: "c2", Parent: "e", Status: "open"},
+			},
+			lists:    [][]string{{"e", "sub", "leaf", "c2"}},
+			expanded: []string{"e", "sub"},
+			want:     [][]string{{"e", "c2 ├─", "sub └─", "leaf    └─"}},
+		},
+		{
+			name: "child in another panel is listed under its parent only",
+			tasks: []models.Task{
+				{ID: "e", Type: "epic", Status: "open"},
+				{ID: "done", Parent: "e", Status: "closed"},
+				{ID: "other", Status: "closed"},
+			},
+			lists:    [][]string{{"e"}, {"done", "other"}},
+			expanded: []string{"e"},
+			want:     [][]string{{"e", "done └─"}, {"other"}},
+		},
+		{
+			name: "parent loop is listed once",
+			tasks: []models.Task{
+				{ID: "a", Type: "epic", Parent: "b", Status: "open"},
+				{ID: "b", Type: "epic", Parent: "a", Status: "open"},
+			},
+			lists:    [][]string{{"a", "b"}},
+			expanded: []string{"a", "b"},
+			want:     [][]string{{"a", "b └─"}},
+		},
+		{
+			name: "parent loop across panels is listed once",
+			tasks: []models.Task{
+				{ID: "a", Type: "epic", Parent: "b", Status: "open"},
+				{ID: "b", Type: "epic", Parent: "a", Status: "closed"},
+			},
+			lists:    [][]string{{"a"}, {"b"}},
+			expanded: []string{"a", "b"},
+			want:     [][]string{{"a", "b └─"}, nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks := append([]models.Task(nil), tt.tasks...)
+			applyEpicChildren(tasks)
+			all := make(map[string]models.Task, len(tasks))
+			for _, task := range tasks {
+				all[task.ID] = task
+			}
+			lists := make([][]models.Task, len(tt.lists))
+			for i, ids := range tt.lists {
+				for _, id := range ids {
+					lists[i] = append(lists[i], all[id])
+				}
+			}
+			expanded := make(map[string]bool)
+			for _, id := range tt.expanded {
+				expanded[id] = true
+			}
+
+			for i, list := range nestEpicChildren(lists, all, expanded) {
+				var got []string
+				for _, task := range list {
+					got = append(got, strings.TrimSpace(task.ID+" "+task.TreePrefix))
+				}
+				if strings.Join(got, "|") != strings.Join(tt.want[i], "|") {
+					t.Errorf("list %d: expected rows %q, got %q", i, tt.want[i], got)
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package app
 import (
 	"fmt"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/textarea"
 	tea "github.com/charmbracelet/bubbletea"
@@ -10,11 +12,14 @@ import (
 	"github.com/mattn/go-runewidth"
 
 	"lazybeads/internal/beads"
+	"lazybeads/internal/models"
 )
 
 func (m *Model) updateForm(msg tea.Msg) tea.Cmd {
 	var cmds []tea.Cmd
 
+	m.warnOnTruncatedPaste(msg)
+
 	switch m.formFocus {
 	case 0:
 		var cmd tea.Cmd
@@ -22,28 +27,28 @@ func (m *Model) updateForm(msg tea.Msg) tea.Cmd {
 		cmds = append(cmds, cmd)
 	case 1:
 		if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "enter" {
-			bumpTextareaHeightForNewline(&m.formDesc)
+			bumpTextareaHeightForNewline(&m.formDesc, m.formFieldHeight)
 		}
 		var cmd tea.Cmd
 		m.formDesc, cmd = m.formDesc.Update(msg)
 		cmds = append(cmds, cmd)
 	case 2:
 		if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "enter" {
-			bumpTextareaHeightForNewline(&m.formNotes)
+			bumpTextareaHeightForNewline(&m.formNotes, m.formFieldHeight)
 		}
 		var cmd tea.Cmd
 		m.formNotes, cmd = m.formNotes.Update(msg)
 		cmds = append(cmds, cmd)
 	case 3:
 		if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "enter" {
-			bumpTextareaHeightForNewline(&m.formDesign)
+			bumpTextareaHeightForNewline(&m.formDesign, m.formFieldHeight)
 		}
 		var cmd tea.Cmd
 		m.formDesign, cmd = m.formDesign.Update(msg)
 		cmds = append(cmds, cmd)
 	case 4:
 		if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "enter" {
-			bumpTextareaHeightForNewline(&m.formAcceptance)
+			bumpTextareaHeightForNewline(&m.formAcceptance, m.formFieldHeight)
 		}
 		var cmd tea.Cmd
 		m.formAcceptance, cmd = m.formAcceptance.Update(msg)
@@ -81,6 +86,22 @@ func (m *Model) updateForm(msg tea.Msg) tea.Cmd {
 			}
 			m.formType = types[idx]
 		}
+	case 7:
+		var cmd tea.Cmd
+		m.formLabels, cmd = m.formLabels.Update(msg)
+		cmds = append(cmds, cmd)
+	case 8:
+		var cmd tea.Cmd
+		m.formAssignee, cmd = m.formAssignee.Update(msg)
+		cmds = append(cmds, cmd)
+	case 9:
+		var cmd tea.Cmd
+		m.formDue, cmd = m.formDue.Update(msg)
+		cmds = append(cmds, cmd)
+	case 10:
+		var cmd tea.Cmd
+		m.formDefer, cmd = m.formDefer.Update(msg)
+		cmds = append(cmds, cmd)
 	}
 
 	m.updateFormTextAreaHeights()
@@ -95,6 +116,13 @@ func (m *Model) resetForm() {
 	m.formAcceptance.SetValue("")
 	m.formPriority = 2
 	m.formType = "feature"
+	m.formLabels.SetValue("")
+	m.formAssignee.SetValue("")
+	m.formDue.SetValue("")
+	m.formDefer.SetValue("")
+	m.formLink = formLink{}
+	m.formWarning = ""
+	m.editingOriginal = models.Task{}
 	m.formFocus = 0
 	m.updateFormFocus()
 	m.updateFormTextAreaHeights()
@@ -106,6 +134,10 @@ func (m *Model) updateFormFocus() {
 	m.formNotes.Blur()
 	m.formDesign.Blur()
 	m.formAcceptance.Blur()
+	m.formLabels.Blur()
+	m.formAssignee.Blur()
+	m.formDue.Blur()
+	m.formDefer.Blur()
 	switch m.formFocus {
 	case 0:
 		m.formTitle.Focus()
@@ -117,6 +149,14 @@ func (m *Model) updateFormFocus() {
 		m.formDesign.Focus()
 	case 4:
 		m.formAcceptance.Focus()
+	case 7:
+		m.formLabels.Focus()
+	case 8:
+		m.formAssignee.Focus()
+	case 9:
+		m.formDue.Focus()
+	case 10:
+		m.formDefer.Focus()
 	}
 }
 
@@ -129,13 +169,13 @@ func (m *Model) applyEditorContentToForm(field editorField, content string) {
 
 	switch field {
 	case editorFieldDescription:
-		m.formDesc.SetValue(content)
+		m.setFormText(&m.formDesc, "Description", content)
 	case editorFieldNotes:
-		m.formNotes.SetValue(content)
+		m.setFormText(&m.formNotes, "Notes", content)
 	case editorFieldDesign:
-		m.formDesign.SetValue(content)
+		m.setFormText(&m.formDesign, "Design", content)
 	case editorFieldAcceptance:
-		m.formAcceptance.SetValue(content)
+		m.setFormText(&m.formAcceptance, "Acceptance criteria", content)
 	}
 
 	m.formFocus = focus
@@ -176,10 +216,10 @@ func (m *Model) updateFormTextAreaHeights() {
 		acceptWidth = 1
 	}
 
-	m.formDesc.SetHeight(calcTextareaHeight(m.formDesc.Value(), descWidth))
-	m.formNotes.SetHeight(calcTextareaHeight(m.formNotes.Value(), notesWidth))
-	m.formDesign.SetHeight(calcTextareaHeight(m.formDesign.Value(), designWidth))
-	m.formAcceptance.SetHeight(calcTextareaHeight(m.formAcceptance.Value(), acceptWidth))
+	m.formDesc.SetHeight(minInt(calcTextareaHeight(m.formDesc.Value(), descWidth), m.formFieldHeight))
+	m.formNotes.SetHeight(minInt(calcTextareaHeight(m.formNotes.Value(), notesWidth), m.formFieldHeight))
+	m.formDesign.SetHeight(minInt(calcTextareaHeight(m.formDesign.Value(), designWidth), m.formFieldHeight))
+	m.formAcceptance.SetHeight(minInt(calcTextareaHeight(m.formAcceptance.Value(), acceptWidth), m.formFieldHeight))
 	m.updateFormSubmitBounds()
 }
 
@@ -212,17 +252,73 @@ func calcTextareaHeight(value string, width int) int {
 	return height
 }
 
-func bumpTextareaHeightForNewline(ta *textarea.Model) {
+func bumpTextareaHeightForNewline(ta *textarea.Model, maxHeight int) {
 	width := ta.Width()
 	if width < 1 {
 		width = 1
 	}
-	target := calcTextareaHeight(ta.Value(), width) + 1
+	target := minInt(calcTextareaHeight(ta.Value(), width)+1, maxHeight)
 	if ta.Height() < target {
 		ta.SetHeight(target)
 	}
 }
 
+// setFormTitle sets the title field, warning if a configured limit cut it short
+func (m *Model) setFormTitle(value string) {
+	m.formTitle.SetValue(value)
+	m.warnIfTruncated("Title", value, m.formTitle.CharLimit)
+}
+
+// setFormText sets a text field, warning if a configured limit cut it short
+func (m *Model) setFormText(ta *textarea.Model, name, value string) {
+	ta.SetValue(value)
+	m.warnIfTruncated(name, value, ta.CharLimit)
+}
+
+func (m *Model) warnIfTruncated(name, value string, limit int) {
+	if limit > 0 && utf8.RuneCountInString(value) > limit {
+		m.formWarning = fmt.Sprintf("%s was truncated from %d to the %d character limit (form.%s in config)",
+			name, utf8.RuneCountInString(value), limit, limitSetting(name))
+	}
+}
+
+// warnOnTruncatedPaste warns when pasted text will not fit in the focused
+// field's character limit, since the inputs drop the excess silently.
+func (m *Model) warnOnTruncatedPaste(msg tea.Msg) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !keyMsg.Paste {
+		return
+	}
+
+	var name string
+	var length, limit int
+	switch m.formFocus {
+	case 0:
+		name, length, limit = "Title", utf8.RuneCountInString(m.formTitle.Value()), m.formTitle.CharLimit
+	case 1:
+		name, length, limit = "Description", m.formDesc.Length(), m.formDesc.CharLimit
+	case 2:
+		name, length, limit = "Notes", m.formNotes.Length(), m.formNotes.CharLimit
+	case 3:
+		name, length, limit = "Design", m.formDesign.Length(), m.formDesign.CharLimit
+	case 4:
+		name, length, limit = "Acceptance criteria", m.formAcceptance.Length(), m.formAcceptance.CharLimit
+	default:
+		return
+	}
+	if limit > 0 && length+len(keyMsg.Runes) > limit {
+		m.formWarning = fmt.Sprintf("Paste into %s was truncated at the %d character limit (form.%s in config)",
+			name, limit, limitSetting(name))
+	}
+}
+
+func limitSetting(name string) string {
+	if name == "Title" {
+		return "titleLimit"
+	}
+	return "textLimit"
+}
+
 func (m *Model) updateFormSubmitBounds() {
 	blocks, button, _ := m.formViewBlocks()
 	y := 0
@@ -238,32 +334,46 @@ func (m *Model) updateFormSubmitBounds() {
 }
 
 func (m *Model) submitForm() tea.Cmd {
-	title := strings.TrimSpace(m.formTitle.Value())
-	if title == "" {
-		m.err = fmt.Errorf("title is required")
+	values, err := m.readFormValues(time.Now())
+	if err != nil {
+		m.err = err
 		return nil
 	}
 
 	if m.editing {
+		opts, changes := diffTaskUpdate(m.editingOriginal, values)
+		if len(changes) == 0 {
+			m.clearFormDraft()
+			m.mode = ViewList
+			return m.flashStatus("No changes")
+		}
+		taskID := m.editingID
 		return func() tea.Msg {
-			err := m.client.Update(m.editingID, beads.UpdateOptions{
-				Title:    title,
-				Priority: &m.formPriority,
-			})
-			return taskUpdatedMsg{err: err}
+			err := m.client.Update(taskID, opts)
+			return taskUpdatedMsg{taskID: taskID, changes: changes, err: err}
 		}
 	}
 
+	opts := beads.CreateOptions{
+		Title:              values.Title,
+		Description:        values.Description,
+		Notes:              values.Notes,
+		Design:             values.Design,
+		AcceptanceCriteria: values.AcceptanceCriteria,
+		Type:               values.Type,
+		Priority:           values.Priority,
+		Labels:             values.Labels,
+		Assignee:           values.Assignee,
+		Due:                values.Due,
+		Defer:              values.Defer,
+	}
+	link := m.formLink
+	parentID := ""
+	if link.kind == linkChild {
+		parentID = link.targetID
+	}
 	return func() tea.Msg {
-		task, err := m.client.Create(beads.CreateOptions{
-			Title:              title,
-			Description:        m.formDesc.Value(),
-			Notes:              m.formNotes.Value(),
-			Design:             m.formDesign.Value(),
-			AcceptanceCriteria: m.formAcceptance.Value(),
-			Type:               m.formType,
-			Priority:           m.formPriority,
-		})
-		return taskCreatedMsg{task: task, err: err}
+		task, err := createLinkedTask(m.client, opts, link)
+		return taskCreatedMsg{task: task, parentID: parentID, linkedID: link.targetID, err: err}
 	}
 }
@@ -0,0 +1,130 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+// maxGotoCompletions is how many matching issues the goto prompt lists
+const maxGotoCompletions = 8
+
+// gotoCompletions returns issues whose full or short ID starts with query,
+// then those whose ID contains it, each in ID order
+func gotoCompletions(tasks []models.Task, query string) []models.Task {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil
+	}
+
+	var prefixed, containing []models.Task
+	for _, task := range tasks {
+		id := strings.ToLower(task.ID)
+		switch {
+		case strings.HasPrefix(id, query), strings.HasPrefix(strings.ToLower(shortenIssueID(task.ID)), query):
+			prefixed = append(prefixed, task)
+		case strings.Contains(id, query):
+			containing = append(containing, task)
+		}
+	}
+	byID := func(list []models.Task) {
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].ID < list[j].ID
+		})
+	}
+	byID(prefixed)
+	byID(containing)
+
+	matches := append(prefixed, containing...)
+	if len(matches) > maxGotoCompletions {
+		matches = matches[:maxGotoCompletions]
+	}
+	return matches
+}
+
+// openGotoModal prompts for an issue ID to jump to
+func (m *Model) openGotoModal() tea.Cmd {
+	m.gotoReturn = m.mode
+	m.modal = ui.NewModalInput("Go to Issue", "", "")
+	m.modal.Input.Placeholder = "full or short ID"
+	m.gotoQuery = ""
+	m.gotoMatches = nil
+	m.gotoCursor = 0
+	m.updateGotoCompletions()
+	m.mode = ViewGoto
+	return m.modal.Input.Focus()
+}
+
+// updateGotoCompletions lists the issues matching the typed ID below the
+// input, keeping the highlighted one while the query is unchanged
+func (m *Model) updateGotoCompletions() {
+	query := m.modal.InputValue()
+	if query != m.gotoQuery {
+		m.gotoQuery = query
+		m.gotoMatches = gotoCompletions(m.tasks, query)
+		m.gotoCursor = 0
+	}
+
+	hint := ui.HelpDescStyle.Render("tab: complete  up/down: choose")
+	if strings.TrimSpace(query) == "" {
+		m.modal.Body = hint
+		return
+	}
+	if len(m.gotoMatches) == 0 {
+		m.modal.Body = ui.HelpDescStyle.Render("No matching issues")
+		return
+	}
+
+	idStyle := lipgloss.NewStyle().Foreground(ui.ColorSecondary)
+	var b strings.Builder
+	for i, task := range m.gotoMatches {
+		prefix := strings.Repeat(" ", lipgloss.Width(ui.Glyphs.Cursor))
+		if i == m.gotoCursor {
+			prefix = ui.Glyphs.Cursor
+		}
+		b.WriteString(prefix + ui.StatusIcon(task.Status) + " " + idStyle.Render(task.ID) + " " + truncateTitle(task.Title, 34) + "\n")
+	}
+	b.WriteString("\n" + hint)
+	m.modal.Body = b.String()
+}
+
+func (m *Model) handleGotoKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "up", "ctrl+k":
+		if m.gotoCursor > 0 {
+			m.gotoCursor--
+		}
+		m.updateGotoCompletions()
+	case "down", "ctrl+j":
+		if m.gotoCursor < len(m.gotoMatches)-1 {
+			m.gotoCursor++
+		}
+		m.updateGotoCompletions()
+	case "tab":
+		if m.gotoCursor < len(m.gotoMatches) {
+			m.modal.Input.SetValue(m.gotoMatches[m.gotoCursor].ID)
+			m.modal.Input.CursorEnd()
+		}
+	case "enter":
+		query := strings.TrimSpace(m.modal.InputValue())
+		id, ok := m.resolveIssueID(query)
+		if !ok && m.gotoCursor < len(m.gotoMatches) {
+			id, ok = m.gotoMatches[m.gotoCursor].ID, true
+		}
+		if !ok {
+			m.modal.Body = ui.ErrorStyle.Render(fmt.Sprintf("No issue %q", query))
+			return nil
+		}
+		m.mode = m.gotoReturn
+		if !m.revealTask(id) {
+			m.err = fmt.Errorf("issue %s is not in any panel", id)
+		}
+	}
+	return nil
+}
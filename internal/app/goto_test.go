@@ -0,0 +1,70 @@
+package app
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/models"
+)
+
+func TestGotoCompletions(t *testing.T) {
+	tasks := []models.Task{{ID: "lazybeads-7qx"}, {ID: "lazybeads-7ab"}, {ID: "lazybeads-x7q"}, {ID: "other-1"}}
+
+	var got []string
+	for _, task := range gotoCompletions(tasks, "7") {
+		got = append(got, task.ID)
+	}
+	expected := []string{"lazybeads-7ab", "lazybeads-7qx", "lazybeads-x7q"}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("completion %d: expected %s, got %s", i, expected[i], got[i])
+		}
+	}
+
+	if matches := gotoCompletions(tasks, "LAZYBEADS-7Q"); len(matches) != 1 || matches[0].ID != "lazybeads-7qx" {
+		t.Errorf("expected a case-insensitive full ID prefix match, got %+v", matches)
+	}
+}
+
+func TestGotoRevealsFilteredClosedIssue(t *testing.T) {
+	m := newTestModel(t,
+		models.Task{ID: "lazybeads-a1", Title: "Open one", Status: "open"},
+		models.Task{ID: "lazybeads-7qx", Title: "Finished", Status: "closed"},
+	)
+	m.filterQuery = "open"
+	m.distributeTasks()
+
+	press := func(msg tea.KeyMsg) {
+		updated, _ := m.Update(msg)
+		m = updated.(Model)
+	}
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(":")})
+	if m.mode != ViewGoto {
+		t.Fatalf("expected the goto prompt, got mode %v", m.mode)
+	}
+	for _, r := range "7q" {
+		press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if len(m.gotoMatches) != 1 {
+		t.Fatalf("expected one completion, got %+v", m.gotoMatches)
+	}
+	press(tea.KeyMsg{Type: tea.KeyTab})
+	if m.modal.InputValue() != "lazybeads-7qx" {
+		t.Errorf("expected tab to complete the ID, got %q", m.modal.InputValue())
+	}
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if m.mode != ViewList || m.selected == nil || m.selected.ID != "lazybeads-7qx" {
+		t.Fatalf("expected lazybeads-7qx selected in the list, got mode %v %+v", m.mode, m.selected)
+	}
+	if m.filterQuery != "" {
+		t.Errorf("expected the filter hiding the issue to be cleared, got %q", m.filterQuery)
+	}
+	if m.focusedPanel != FocusClosed || m.closedPanel.IsCollapsed() {
+		t.Errorf("expected the closed panel focused and expanded")
+	}
+}
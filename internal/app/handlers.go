@@ -45,6 +45,30 @@ func (m *Model) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 		return m.handleSelectBarKeys(msg)
 	case ViewFilter:
 		return m.handleFilterKeys(msg)
+	case ViewCycles:
+		return m.handleCyclesKeys(msg)
+	case ViewAnalysis:
+		return m.handleAnalysisKeys(msg)
+	case ViewChanges:
+		return m.handleChangesKeys(msg)
+	case ViewLinkKind:
+		return m.handleLinkKindKeys(msg)
+	case ViewTemplate:
+		return m.handleTemplateKeys(msg)
+	case ViewCapture:
+		return m.handleCaptureKeys(msg)
+	case ViewConflict:
+		return m.handleConflictKeys(msg)
+	case ViewRestoreDraft:
+		return m.handleRestoreDraftKeys(msg)
+	case ViewChecklist:
+		return m.handleChecklistKeys(msg)
+	case ViewLinkPicker:
+		return m.handleLinkPickerKeys(msg)
+	case ViewPalette:
+		return m.handlePaletteKeys(msg)
+	case ViewGoto:
+		return m.handleGotoKeys(msg)
 	}
 	return nil
 }
@@ -53,76 +77,138 @@ func (m *Model) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 	// First, let the focused panel handle navigation keys
 	switch m.focusedPanel {
 	case FocusInProgress:
-		if m.inProgressPanel.HandleKey(msg, m.keys) {
+		if m.inProgressPanel.HandleKey(msg, m.listKeys) {
 			m.selected = m.getSelectedTask()
 			return nil
 		}
 	case FocusOpen:
-		if m.openPanel.HandleKey(msg, m.keys) {
+		if m.openPanel.HandleKey(msg, m.listKeys) {
 			m.selected = m.getSelectedTask()
 			return nil
 		}
 	case FocusClosed:
-		if m.closedPanel.HandleKey(msg, m.keys) {
+		if m.closedPanel.HandleKey(msg, m.listKeys) {
 			m.selected = m.getSelectedTask()
 			return nil
 		}
 	}
 
 	switch {
-	case key.Matches(msg, m.keys.Select):
+	case key.Matches(msg, m.listKeys.Select):
 		if task := m.getSelectedTask(); task != nil {
 			m.selected = task
 			m.updateDetailContent()
 			m.mode = ViewDetail
 		}
 
-	case key.Matches(msg, m.keys.Add):
+	case key.Matches(msg, m.listKeys.Add):
 		m.resetForm()
 		m.editing = false
-		m.mode = ViewForm
-		m.formTitle.Focus()
+		m.showFormOrTemplates()
 
-	case key.Matches(msg, m.keys.Delete):
+	case key.Matches(msg, m.listKeys.Delete):
 		if task := m.getSelectedTask(); task != nil {
 			m.confirmMsg = fmt.Sprintf("Delete task %s?", task.ID)
 			taskID := task.ID
 			m.confirmAction = func() tea.Cmd {
 				return func() tea.Msg {
 					err := m.client.Delete(taskID)
-					return taskDeletedMsg{err: err}
+					return taskDeletedMsg{taskID: taskID, err: err}
 				}
 			}
 			m.mode = ViewConfirm
 		}
 
-	case key.Matches(msg, m.keys.PrevView):
+	case key.Matches(msg, m.listKeys.PrevView):
 		m.cyclePanelFocus(-1)
 
-	case key.Matches(msg, m.keys.NextView):
+	case key.Matches(msg, m.listKeys.NextView):
 		m.cyclePanelFocus(1)
 
-	case key.Matches(msg, m.keys.PanelShrink):
+	case key.Matches(msg, m.listKeys.PanelShrink):
 		m.panelAdjust -= panelWidthStep
 		m.updateSizes()
 
-	case key.Matches(msg, m.keys.PanelExpand):
+	case key.Matches(msg, m.listKeys.PanelExpand):
 		m.panelAdjust += panelWidthStep
 		m.updateSizes()
 
-	case key.Matches(msg, m.keys.Refresh):
+	case key.Matches(msg, m.listKeys.Refresh):
+		m.invalidateDetailData()
 		return m.loadTasks()
 
-	case key.Matches(msg, m.keys.Help):
+	case key.Matches(msg, m.listKeys.Cycles):
+		if len(m.cycles) == 0 {
+			return m.flashStatus("No dependency cycles")
+		}
+		m.openCyclesModal()
+
+	case key.Matches(msg, m.listKeys.Analysis):
+		m.openAnalysis()
+
+	case key.Matches(msg, m.listKeys.Changes):
+		m.openChanges()
+
+	case key.Matches(msg, m.listKeys.QuickCapture):
+		return m.openCaptureModal()
+
+	case key.Matches(msg, m.listKeys.AddLinked):
+		if task := m.getSelectedTask(); task != nil {
+			m.openLinkKindModal(task)
+		}
+
+	case key.Matches(msg, m.listKeys.ToggleExpand):
+		if task := m.getSelectedTask(); task != nil {
+			return m.toggleEpic(task)
+		}
+
+	case key.Matches(msg, m.listKeys.AddChild):
+		if task := m.getSelectedTask(); task != nil {
+			if !task.IsEpic() {
+				return m.flashStatus("Child issues can only be added to epics")
+			}
+			m.startLinkedForm(task, linkChild)
+		}
+
+	case key.Matches(msg, m.listKeys.Help):
 		m.mode = ViewHelp
 
-	case key.Matches(msg, m.keys.EditTitle):
+	case key.Matches(msg, m.listKeys.Palette):
+		return m.openPalette()
+
+	case key.Matches(msg, m.listKeys.GotoIssue):
+		return m.openGotoModal()
+
+	case key.Matches(msg, m.listKeys.EditTitle):
 		if task := m.getSelectedTask(); task != nil {
 			m.modal = ui.NewModalInput("Edit Title", task.ID, task.Title)
 			m.mode = ViewEditTitle
 		}
 
-	case key.Matches(msg, m.keys.EditStatus):
+	case key.Matches(msg, m.listKeys.EditAll):
+		if task := m.getSelectedTask(); task != nil {
+			m.openEditForm(task)
+		}
+
+	case key.Matches(msg, m.listKeys.Checklist):
+		return m.openChecklist()
+
+	case key.Matches(msg, m.listKeys.OpenLink):
+		return m.openLinkPicker()
+
+	case key.Matches(msg, m.listKeys.NextTab):
+		return m.cycleDetailTab(1)
+
+	case key.Matches(msg, m.listKeys.PrevTab):
+		return m.cycleDetailTab(-1)
+
+	case key.Matches(msg, m.listKeys.DetailDown):
+		m.scrollDetail(1)
+
+	case key.Matches(msg, m.listKeys.DetailUp):
+		m.scrollDetail(-1)
+
+	case key.Matches(msg, m.listKeys.EditStatus):
 		if task := m.getSelectedTask(); task != nil {
 			options := []ui.ModalOption{
 				{Label: "open", Value: "open", Shortcut: "o"},
@@ -133,7 +219,7 @@ func (m *Model) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 			m.mode = ViewEditStatus
 		}
 
-	case key.Matches(msg, m.keys.EditPriority):
+	case key.Matches(msg, m.listKeys.EditPriority):
 		if task := m.getSelectedTask(); task != nil {
 			options := []ui.ModalOption{
 				{Label: "P0 - Critical", Value: "0", Shortcut: "0"},
@@ -146,7 +232,7 @@ func (m *Model) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 			m.mode = ViewEditPriority
 		}
 
-	case key.Matches(msg, m.keys.EditType):
+	case key.Matches(msg, m.listKeys.EditType):
 		if task := m.getSelectedTask(); task != nil {
 			options := []ui.ModalOption{
 				{Label: "task", Value: "task", Shortcut: "t"},
@@ -159,34 +245,34 @@ func (m *Model) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 			m.mode = ViewEditType
 		}
 
-	case key.Matches(msg, m.keys.EditDescription):
+	case key.Matches(msg, m.listKeys.EditDescription):
 		if task := m.getSelectedTask(); task != nil {
 			return m.editDescriptionInEditor(task)
 		}
 
-	case key.Matches(msg, m.keys.EditNotes):
+	case key.Matches(msg, m.listKeys.EditNotes):
 		if task := m.getSelectedTask(); task != nil {
 			return m.editFieldInEditor(task, editorFieldNotes, task.Notes)
 		}
 
-	case key.Matches(msg, m.keys.EditDesign):
+	case key.Matches(msg, m.listKeys.EditDesign):
 		if task := m.getSelectedTask(); task != nil {
 			return m.editFieldInEditor(task, editorFieldDesign, task.Design)
 		}
 
-	case key.Matches(msg, m.keys.EditAcceptance):
+	case key.Matches(msg, m.listKeys.EditAcceptance):
 		if task := m.getSelectedTask(); task != nil {
 			return m.editFieldInEditor(task, editorFieldAcceptance, task.AcceptanceCriteria)
 		}
 
-	case key.Matches(msg, m.keys.Filter):
+	case key.Matches(msg, m.listKeys.Filter):
 		// Enter inline search mode in status bar
 		m.searchMode = true
 		m.searchInput.SetValue(m.filterQuery)
 		m.searchInput.Focus()
 		return m.searchInput.Focus() // Return blink command
 
-	case key.Matches(msg, m.keys.CopyID):
+	case key.Matches(msg, m.listKeys.CopyID):
 		if task := m.getSelectedTask(); task != nil {
 			taskID := task.ID
 			return func() tea.Msg {
@@ -207,10 +293,44 @@ func (m *Model) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 
 func (m *Model) handleDetailKeys(msg tea.KeyMsg) tea.Cmd {
 	switch {
-	case key.Matches(msg, m.keys.Cancel), key.Matches(msg, m.keys.Select):
+	case key.Matches(msg, m.detailKeys.Select) && m.detailLink >= 0:
+		return m.followDetailLink()
+	case key.Matches(msg, m.detailKeys.Cancel), key.Matches(msg, m.detailKeys.Select):
 		m.mode = ViewList
-	case key.Matches(msg, m.keys.Help):
+	case key.Matches(msg, m.detailKeys.NextTab):
+		return m.cycleDetailTab(1)
+	case key.Matches(msg, m.detailKeys.PrevTab):
+		return m.cycleDetailTab(-1)
+	case key.Matches(msg, m.detailKeys.DetailDown):
+		m.scrollDetail(1)
+	case key.Matches(msg, m.detailKeys.DetailUp):
+		m.scrollDetail(-1)
+	case key.Matches(msg, m.detailKeys.NextLink):
+		m.selectDetailLink(1)
+	case key.Matches(msg, m.detailKeys.PrevLink):
+		m.selectDetailLink(-1)
+	case key.Matches(msg, m.detailKeys.HistoryBack):
+		return m.navigateHistory(-1)
+	case key.Matches(msg, m.detailKeys.HistoryFwd):
+		return m.navigateHistory(1)
+	case key.Matches(msg, m.detailKeys.Help):
 		m.mode = ViewHelp
+	case key.Matches(msg, m.detailKeys.Palette):
+		return m.openPalette()
+	case key.Matches(msg, m.detailKeys.GotoIssue):
+		return m.openGotoModal()
+	case key.Matches(msg, m.detailKeys.QuickCapture):
+		return m.openCaptureModal()
+	case key.Matches(msg, m.detailKeys.EditAll):
+		if m.selected != nil {
+			m.openEditForm(m.selected)
+		}
+	case key.Matches(msg, m.detailKeys.ToggleMarkdown):
+		return m.toggleMarkdown()
+	case key.Matches(msg, m.detailKeys.Checklist):
+		return m.openChecklist()
+	case key.Matches(msg, m.detailKeys.OpenLink):
+		return m.openLinkPicker()
 	default:
 		// Check custom commands
 		if cmd := m.matchCustomCommand(msg, "detail"); cmd != nil {
@@ -220,22 +340,28 @@ func (m *Model) handleDetailKeys(msg tea.KeyMsg) tea.Cmd {
 	return nil
 }
 
+// toggleMarkdown switches the detail pane between rendered and raw markdown
+func (m *Model) toggleMarkdown() tea.Cmd {
+	m.rawMarkdown = !m.rawMarkdown
+	m.updateDetailContent()
+	if m.rawMarkdown {
+		return m.flashStatus("Showing raw markdown")
+	}
+	return m.flashStatus("Rendering markdown")
+}
+
 func (m *Model) handleFormKeys(msg tea.KeyMsg) tea.Cmd {
 	switch {
 	case key.Matches(msg, m.keys.EditFormField):
 		return m.editFocusedFormFieldInEditor()
 
-	case key.Matches(msg, m.keys.Cancel):
-		m.mode = ViewList
-		return nil
-
 	case key.Matches(msg, m.keys.Submit):
-		m.formFocus = 7
+		m.formFocus = formSubmitFocus
 		m.updateFormFocus()
 		return nil
 
 	case msg.String() == "enter":
-		if m.formFocus == 7 {
+		if m.formFocus == formSubmitFocus {
 			return m.submitForm()
 		}
 
@@ -262,7 +388,7 @@ func (m *Model) handleFormMouse(msg tea.MouseMsg) tea.Cmd {
 	}
 
 	if msg.X >= bounds.X && msg.X < bounds.X+bounds.W && msg.Y >= bounds.Y && msg.Y < bounds.Y+bounds.H {
-		m.formFocus = 7
+		m.formFocus = formSubmitFocus
 		m.updateFormFocus()
 		return m.submitForm()
 	}
@@ -344,10 +470,10 @@ func (m *Model) handleHelpKeys(msg tea.KeyMsg) tea.Cmd {
 func (m *Model) handleConfirmKeys(msg tea.KeyMsg) tea.Cmd {
 	switch msg.String() {
 	case "y", "Y":
+		m.mode = ViewList
 		if m.confirmAction != nil {
 			return m.confirmAction()
 		}
-		m.mode = ViewList
 	case "n", "N", "esc":
 		m.mode = ViewList
 	}
@@ -366,7 +492,7 @@ func (m *Model) handleTitleBarKeys(msg tea.KeyMsg) tea.Cmd {
 					err := m.client.Update(taskID, beads.UpdateOptions{
 						Title: newTitle,
 					})
-					return taskUpdatedMsg{err: err}
+					return taskUpdatedMsg{taskID: taskID, err: err}
 				}
 			}
 		}
@@ -418,7 +544,7 @@ func (m *Model) applyModalSelection(taskID, value string) tea.Cmd {
 			err := m.client.Update(taskID, beads.UpdateOptions{
 				Status: value,
 			})
-			return taskUpdatedMsg{err: err}
+			return taskUpdatedMsg{taskID: taskID, err: err}
 		}
 	case "Edit Priority":
 		priority := 2
@@ -427,14 +553,14 @@ func (m *Model) applyModalSelection(taskID, value string) tea.Cmd {
 			err := m.client.Update(taskID, beads.UpdateOptions{
 				Priority: &priority,
 			})
-			return taskUpdatedMsg{err: err}
+			return taskUpdatedMsg{taskID: taskID, err: err}
 		}
 	case "Edit Type":
 		return func() tea.Msg {
 			err := m.client.Update(taskID, beads.UpdateOptions{
 				Type: value,
 			})
-			return taskUpdatedMsg{err: err}
+			return taskUpdatedMsg{taskID: taskID, err: err}
 		}
 	}
 	return nil
@@ -498,6 +624,12 @@ func (m *Model) editFocusedFormFieldInEditor() tea.Cmd {
 }
 
 func (m *Model) editFieldInEditor(task *models.Task, field editorField, content string) tea.Cmd {
+	m.editorBaseContent = content
+	m.editorBaseUpdatedAt = task.UpdatedAt
+	// Reopen text kept from an earlier session whose save failed
+	if kept, ok := m.draftStore.LoadEditor(task.ID, string(field)); ok {
+		content = kept
+	}
 	return m.startExternalEditor(field, content, task.ID, false)
 }
 
@@ -536,16 +668,26 @@ func (m *Model) startExternalEditor(field editorField, content string, targetID
 	tmpPath := tmpfile.Name()
 	c := exec.Command(editor, tmpPath)
 
+	store := m.draftStore
 	return tea.ExecProcess(c, func(err error) tea.Msg {
-		defer os.Remove(tmpPath)
 		if err != nil {
+			os.Remove(tmpPath)
 			return editorFinishedMsg{err: err}
 		}
 		content, readErr := os.ReadFile(tmpPath)
 		if readErr != nil {
 			return editorFinishedMsg{err: readErr}
 		}
-		return editorFinishedMsg{content: stripEditorMetadata(string(content))}
+		text := stripEditorMetadata(string(content))
+
+		// Keep issue edits until they are saved so a failed update can be retried
+		if targetID != "" {
+			if saveErr := store.SaveEditor(targetID, string(field), text); saveErr != nil {
+				return editorFinishedMsg{err: fmt.Errorf("failed to keep editor content, it is still in %s: %w", tmpPath, saveErr)}
+			}
+		}
+		os.Remove(tmpPath)
+		return editorFinishedMsg{content: text}
 	})
 }
 
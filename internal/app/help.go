@@ -3,6 +3,7 @@ package app
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/list"
@@ -63,12 +64,10 @@ func helpItemsToListItems(items []helpItem) []list.Item {
 	return listItems
 }
 
-func buildHelpItems(keys ui.KeyMap, customCmds []config.CustomCommand) []helpItem {
-	customKeySet := make(map[string]struct{}, len(customCmds))
-	for _, cmd := range customCmds {
-		customKeySet[cmd.Key] = struct{}{}
-	}
-
+// buildHelpItems lists the effective bindings of the issue list, then
+// detail view bindings that were remapped away from them, then custom
+// commands
+func buildHelpItems(keys, detailKeys ui.KeyMap, customCmds []config.CustomCommand) []helpItem {
 	var items []helpItem
 	for _, group := range keys.FullHelp() {
 		for _, binding := range group {
@@ -76,9 +75,6 @@ func buildHelpItems(keys ui.KeyMap, customCmds []config.CustomCommand) []helpIte
 			if help.Key == "" || help.Desc == "" {
 				continue
 			}
-			if _, isCustom := customKeySet[help.Key]; isCustom {
-				continue
-			}
 			trigger := firstBindingKey(binding.Keys())
 			items = append(items, helpItem{
 				key:     help.Key,
@@ -89,10 +85,28 @@ func buildHelpItems(keys ui.KeyMap, customCmds []config.CustomCommand) []helpIte
 		}
 	}
 
+	for _, action := range detailActions {
+		help := detailKeys.Binding(action).Help()
+		if help.Key == "" || help.Desc == "" || help.Key == keys.Binding(action).Help().Key {
+			continue
+		}
+		// Detail bindings only work in the detail view, so they are not run from here
+		items = append(items, helpItem{
+			key:     help.Key,
+			desc:    help.Desc,
+			context: "detail",
+			kind:    helpItemBinding,
+		})
+	}
+
 	for _, cmd := range customCmds {
+		desc := cmd.Description
+		if action := shadowingAction(keys, detailKeys, cmd); action != "" {
+			desc += " (shadowed by " + action + ")"
+		}
 		items = append(items, helpItem{
 			key:     cmd.Key,
-			desc:    cmd.Description,
+			desc:    desc,
 			context: cmd.Context,
 			trigger: cmd.Key,
 			kind:    helpItemCustom,
@@ -231,9 +245,22 @@ func keyMsgFromString(key string) tea.KeyMsg {
 		return tea.KeyMsg{Type: tea.KeyCtrlD}
 	case "ctrl+s":
 		return tea.KeyMsg{Type: tea.KeyCtrlS}
-	default:
-		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
 	}
+
+	if rest, ok := strings.CutPrefix(key, "alt+"); ok && rest != "" {
+		msg := keyMsgFromString(rest)
+		msg.Alt = true
+		return msg
+	}
+	if rest, ok := strings.CutPrefix(key, "ctrl+"); ok && len(rest) == 1 && rest[0] >= 'a' && rest[0] <= 'z' {
+		return tea.KeyMsg{Type: tea.KeyCtrlA + tea.KeyType(rest[0]-'a')}
+	}
+	if rest, ok := strings.CutPrefix(key, "f"); ok {
+		if n, err := strconv.Atoi(rest); err == nil && n >= 1 && n <= 20 {
+			return tea.KeyMsg{Type: tea.KeyF1 - tea.KeyType(n-1)} // function keys count down
+		}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
 }
 
 func helpModalSize(width, height int) (int, int) {
@@ -279,6 +306,10 @@ func (d helpDelegate) Render(w io.Writer, m list.Model, index int, item list.Ite
 		contextText = contextStyle.Render(" (" + helpItem.context + ")")
 	}
 	line := keyText + " " + descText + contextText
+	if ui.NoColor() {
+		// Leave room for the selection marker
+		line = "  " + line
+	}
 
 	width := m.Width()
 	if width > 0 {
@@ -287,14 +318,17 @@ func (d helpDelegate) Render(w io.Writer, m list.Model, index int, item list.Ite
 
 	if index == m.Index() {
 		selectedStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("15")).
-			Background(lipgloss.Color("#2a4a6d")).
+			Foreground(ui.ColorSelectionText).
+			Background(ui.ColorSelection).
 			Bold(true)
 		rawContext := ""
 		if helpItem.context != "" {
 			rawContext = " (" + helpItem.context + ")"
 		}
 		raw := fmt.Sprintf("%-10s %s%s", helpItem.key, helpItem.desc, rawContext)
+		if ui.NoColor() {
+			raw = "> " + raw
+		}
 		if width > 0 {
 			selectedStyle = selectedStyle.Width(width)
 		}
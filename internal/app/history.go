@@ -0,0 +1,166 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+// maxDiffValueLen caps old and new values shown in the History tab
+const maxDiffValueLen = 40
+
+// historyLoadedMsg is sent when the audit trail of an issue has been fetched
+type historyLoadedMsg struct {
+	taskID string
+	events []models.Event
+	err    error
+}
+
+// fieldDiff is a changed field of an audit event
+type fieldDiff struct {
+	field    string
+	old, new string
+}
+
+// loadHistoryIfNeeded fetches the selected issue's audit trail once the
+// History tab needs it
+func (m *Model) loadHistoryIfNeeded() tea.Cmd {
+	if m.selected == nil || m.detailTab != detailTabHistory {
+		return nil
+	}
+	if !m.markDetailFetch(&m.historyFetched) {
+		return nil
+	}
+	id := m.selected.ID
+
+	client := m.client
+	return func() tea.Msg {
+		events, err := client.History(id)
+		return historyLoadedMsg{taskID: id, events: events, err: err}
+	}
+}
+
+// describeEvent returns a one-line summary of an audit event and the fields
+// it changed
+func describeEvent(e models.Event) (string, []fieldDiff) {
+	comment := ""
+	if e.Comment != nil {
+		comment = strings.TrimSpace(*e.Comment)
+	}
+	value := func(v *string) string {
+		if v == nil {
+			return ""
+		}
+		return *v
+	}
+	withComment := func(summary string) string {
+		if comment == "" {
+			return summary
+		}
+		return summary + ": " + truncateValue(comment)
+	}
+
+	switch e.Type {
+	case "created":
+		return "Created", nil
+	case "status_changed":
+		return "Changed status", []fieldDiff{{field: "status", old: value(e.OldValue), new: value(e.NewValue)}}
+	case "updated":
+		diffs := eventFieldDiffs(value(e.OldValue), value(e.NewValue))
+		if len(diffs) == 0 {
+			return withComment("Updated"), nil
+		}
+		return "Updated", diffs
+	case "commented":
+		return withComment("Commented"), nil
+	case "closed":
+		return withComment("Closed"), nil
+	case "reopened":
+		return withComment("Reopened"), nil
+	case "label_added", "label_removed", "dependency_added", "dependency_removed":
+		summary := strings.ReplaceAll(e.Type, "_", " ")
+		summary = strings.ToUpper(summary[:1]) + summary[1:]
+		if v := value(e.NewValue) + value(e.OldValue); v != "" {
+			summary += ": " + v
+		}
+		return summary, nil
+	}
+
+	summary := strings.ReplaceAll(e.Type, "_", " ")
+	if summary == "" {
+		summary = "event"
+	}
+	return withComment(strings.ToUpper(summary[:1]) + summary[1:]), nil
+}
+
+// eventFieldDiffs compares the JSON objects of an "updated" event. Only
+// fields present in the new value are changes; the old value may hold the
+// whole issue.
+func eventFieldDiffs(oldJSON, newJSON string) []fieldDiff {
+	var before, after map[string]any
+	if json.Unmarshal([]byte(newJSON), &after) != nil || len(after) == 0 {
+		return nil
+	}
+	_ = json.Unmarshal([]byte(oldJSON), &before)
+
+	fields := make([]string, 0, len(after))
+	for field := range after {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var diffs []fieldDiff
+	for _, field := range fields {
+		old, updated := formatEventValue(before[field]), formatEventValue(after[field])
+		if old == updated {
+			continue
+		}
+		diffs = append(diffs, fieldDiff{field: field, old: old, new: updated})
+	}
+	return diffs
+}
+
+// formatEventValue renders a JSON value on one short line
+func formatEventValue(v any) string {
+	var s string
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		s = v
+	case float64:
+		s = fmt.Sprintf("%g", v)
+	default:
+		data, _ := json.Marshal(v)
+		s = string(data)
+	}
+	return truncateValue(s)
+}
+
+// truncateValue collapses whitespace and shortens long values
+func truncateValue(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if runes := []rune(s); len(runes) > maxDiffValueLen {
+		s = string(runes[:maxDiffValueLen-1]) + ui.Glyphs.Ellipsis
+	}
+	return s
+}
+
+// renderFieldDiff draws "field: old → new" below a history entry
+func renderFieldDiff(d fieldDiff) string {
+	old, updated := d.old, d.new
+	if old == "" {
+		old = ui.Glyphs.Empty
+	}
+	if updated == "" {
+		updated = ui.Glyphs.Empty
+	}
+	return "    " + ui.DetailLabelStyle.UnsetWidth().Render(d.field+":") + " " +
+		ui.HelpDescStyle.Render(old) + " " + ui.Glyphs.Arrow + " " + ui.DetailValueStyle.Render(updated)
+}
@@ -0,0 +1,135 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"lazybeads/internal/config"
+	"lazybeads/internal/ui"
+)
+
+// listActions are the bindings handled in the issue list, where no two may
+// share a key
+var listActions = []string{
+	"up", "down", "top", "bottom", "pageUp", "pageDown",
+	"select", "add", "delete", "addLinked", "quickCapture", "refresh", "cycles", "analysis", "changes",
+	"toggleExpand", "addChild",
+	"editAll", "editTitle", "editStatus", "editPriority", "editType",
+	"editDescription", "editNotes", "editDesign", "editAcceptance", "checklist", "copyID", "openLink",
+	"nextTab", "prevTab", "detailDown", "detailUp",
+	"filter", "prevView", "nextView", "panelShrink", "panelExpand",
+	"help", "palette", "gotoIssue", "quit",
+}
+
+// detailActions are the bindings handled in the detail view
+var detailActions = []string{
+	"select", "cancel", "nextTab", "prevTab", "detailDown", "detailUp",
+	"nextLink", "prevLink", "historyBack", "historyFwd",
+	"help", "palette", "gotoIssue", "quickCapture", "editAll", "toggleMarkdown", "checklist", "openLink",
+}
+
+// keyMaps holds the effective bindings: global ones for every view and
+// the list and detail maps with their context overrides applied on top
+type keyMaps struct {
+	global, list, detail ui.KeyMap
+}
+
+// buildKeyMaps applies the configured keybindings to the defaults. Problems
+// such as unknown actions or keys bound twice in one context are returned
+// rather than failing, so a bad entry never locks the user out.
+func buildKeyMaps(cfg config.KeybindingsConfig, customCmds []config.CustomCommand) (keyMaps, []string) {
+	var problems []string
+	rebind := func(keys *ui.KeyMap, context string, overrides map[string]config.KeyList) {
+		actions := make([]string, 0, len(overrides))
+		for action := range overrides {
+			actions = append(actions, action)
+		}
+		sort.Strings(actions)
+		for _, action := range actions {
+			if err := keys.Rebind(action, overrides[action]); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: %v", context, err))
+			}
+		}
+	}
+
+	global := ui.DefaultKeyMap()
+	rebind(&global, "global", cfg.Global)
+	list, detail := global, global
+	rebind(&list, "list", cfg.List)
+	rebind(&detail, "detail", cfg.Detail)
+
+	customBindings := buildCustomCommandBindings(customCmds)
+	global.CustomCommands = customBindings
+	list.CustomCommands = customBindings
+	detail.CustomCommands = customBindings
+
+	problems = append(problems, keyConflicts(list, "list", listActions, customCmds)...)
+	problems = append(problems, keyConflicts(detail, "detail", detailActions, customCmds)...)
+	return keyMaps{global: global, list: list, detail: detail}, problems
+}
+
+// keyConflicts reports keys bound to more than one action in a context,
+// including custom commands that a built-in binding would shadow
+func keyConflicts(keys ui.KeyMap, context string, actions []string, customCmds []config.CustomCommand) []string {
+	owners := make(map[string][]string)
+	var order []string
+	claim := func(k, owner string) {
+		if len(owners[k]) == 0 {
+			order = append(order, k)
+		}
+		owners[k] = append(owners[k], owner)
+	}
+
+	for _, action := range actions {
+		for _, k := range keys.Binding(action).Keys() {
+			claim(k, action)
+		}
+	}
+	for _, cmd := range customCmds {
+		if cmd.Context == context || cmd.Context == "global" {
+			claim(cmd.Key, fmt.Sprintf("custom command %q", cmd.Description))
+		}
+	}
+
+	var conflicts []string
+	for _, k := range order {
+		if len(owners[k]) > 1 {
+			conflicts = append(conflicts, fmt.Sprintf("%s: key %q is bound to %s", context, k, strings.Join(owners[k], " and ")))
+		}
+	}
+	return conflicts
+}
+
+// boundAction returns the action of a context bound to a key, or ""
+func boundAction(keys ui.KeyMap, actions []string, k string) string {
+	for _, action := range actions {
+		for _, bound := range keys.Binding(action).Keys() {
+			if bound == k {
+				return action
+			}
+		}
+	}
+	return ""
+}
+
+// shadowingAction returns the built-in action that takes a custom command's
+// key in the command's context, or ""
+func shadowingAction(listKeys, detailKeys ui.KeyMap, cmd config.CustomCommand) string {
+	if cmd.Context == "list" || cmd.Context == "global" {
+		if action := boundAction(listKeys, listActions, cmd.Key); action != "" {
+			return action
+		}
+	}
+	if cmd.Context == "detail" || cmd.Context == "global" {
+		return boundAction(detailKeys, detailActions, cmd.Key)
+	}
+	return ""
+}
+
+// KeybindingProblems reports unknown actions and conflicting keys in the
+// keybindings and custom commands of a config
+func KeybindingProblems(cfg *config.Config) []string {
+	_, problems := buildKeyMaps(cfg.Keybindings, cfg.CustomCommands)
+	return problems
+}
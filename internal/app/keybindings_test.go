@@ -0,0 +1,85 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/config"
+)
+
+func TestDefaultKeyMapsHaveNoConflicts(t *testing.T) {
+	if _, problems := buildKeyMaps(config.KeybindingsConfig{}, nil); len(problems) > 0 {
+		t.Errorf("expected default bindings without conflicts, got %v", problems)
+	}
+}
+
+func TestBuildKeyMapsContextOverrides(t *testing.T) {
+	cfg := config.KeybindingsConfig{
+		Global: map[string]config.KeyList{"help": {"f1"}},
+		Detail: map[string]config.KeyList{"nextTab": {"l"}, "toggleMarkdown": {}},
+	}
+	keys, problems := buildKeyMaps(cfg, nil)
+	if len(problems) > 0 {
+		t.Fatalf("unexpected problems: %v", problems)
+	}
+
+	f1 := tea.KeyMsg{Type: tea.KeyF1}
+	if !key.Matches(f1, keys.list.Help) || !key.Matches(f1, keys.detail.Help) {
+		t.Errorf("expected global help override in both contexts, got %v and %v", keys.list.Help.Keys(), keys.detail.Help.Keys())
+	}
+	if got := keys.detail.NextTab.Keys(); len(got) != 1 || got[0] != "l" {
+		t.Errorf("expected detail nextTab on l, got %v", got)
+	}
+	if got := keys.list.NextTab.Keys(); len(got) != 1 || got[0] != "]" {
+		t.Errorf("expected list nextTab to keep ], got %v", got)
+	}
+	if keys.detail.ToggleMarkdown.Enabled() {
+		t.Error("expected toggleMarkdown to be unbound in the detail view")
+	}
+	if keys.detail.NextTab.Help().Key != "l" || keys.detail.NextTab.Help().Desc != "next detail tab" {
+		t.Errorf("expected help text to follow the new key, got %+v", keys.detail.NextTab.Help())
+	}
+}
+
+func TestBuildKeyMapsReportsProblems(t *testing.T) {
+	cfg := config.KeybindingsConfig{
+		List: map[string]config.KeyList{"editStatus": {"R"}, "launch": {"z"}},
+	}
+	customCmds := []config.CustomCommand{
+		{Key: "D", Description: "Open in tmux", Context: "list"},
+		{Key: "Z", Description: "Free key", Context: "global"},
+	}
+
+	_, problems := buildKeyMaps(cfg, customCmds)
+	expected := []string{
+		`list: unknown action "launch"`,
+		`list: key "R" is bound to refresh and editStatus`,
+		`list: key "D" is bound to editDesign and custom command "Open in tmux"`,
+	}
+	if strings.Join(problems, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected problems:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(problems, "\n"))
+	}
+}
+
+func TestHelpItemsShowEffectiveBindings(t *testing.T) {
+	cfg := config.KeybindingsConfig{
+		List:   map[string]config.KeyList{"editStatus": {"S"}},
+		Detail: map[string]config.KeyList{"nextTab": {"l"}},
+	}
+	customCmds := []config.CustomCommand{{Key: "D", Description: "Open in tmux", Context: "list"}}
+	keys, _ := buildKeyMaps(cfg, customCmds)
+
+	var got []string
+	for _, item := range buildHelpItems(keys.list, keys.detail, customCmds) {
+		got = append(got, strings.TrimSpace(item.key+" "+item.desc+" "+item.context))
+	}
+	joined := strings.Join(got, "|")
+	for _, want := range []string{"S edit status", "] next detail tab", "l next detail tab detail", "D Open in tmux (shadowed by editDesign) list"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("expected help item %q in %v", want, got)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/beads"
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+// linkKind describes how a newly created issue relates to an existing one
+type linkKind string
+
+const (
+	linkBlocker  linkKind = "blocker"   // new issue blocks the target
+	linkFollowUp linkKind = "follow-up" // new issue is blocked by the target
+	linkChild    linkKind = "child"     // new issue is a child of the target
+)
+
+// formLink is the pending link for an issue being created in the form
+type formLink struct {
+	kind     linkKind
+	targetID string
+}
+
+// formTitle returns the form heading for a linked create
+func (l formLink) formTitle() string {
+	switch l.kind {
+	case linkBlocker:
+		return "New Blocker of " + l.targetID
+	case linkFollowUp:
+		return "New Follow-up to " + l.targetID
+	case linkChild:
+		return "New Child of " + l.targetID
+	}
+	return "New Task"
+}
+
+// linkedTaskClient is the part of the beads client that creating a linked
+// issue needs
+type linkedTaskClient interface {
+	Create(opts beads.CreateOptions) (*models.Task, error)
+	AddDependency(issueID, dependsOnID, depType string) error
+	Delete(id string) error
+}
+
+// addDependency records the link between the new issue and its target
+func (l formLink) addDependency(client linkedTaskClient, newID string) error {
+	switch l.kind {
+	case linkBlocker:
+		return client.AddDependency(l.targetID, newID, models.DependencyBlocks)
+	case linkFollowUp:
+		return client.AddDependency(newID, l.targetID, models.DependencyBlocks)
+	case linkChild:
+		return client.AddDependency(newID, l.targetID, models.DependencyParentChild)
+	}
+	return fmt.Errorf("unknown link kind: %s", l.kind)
+}
+
+// createLinkedTask creates a task and links it to the target. If linking
+// fails the new task is deleted again so no half-linked issue is left behind.
+func createLinkedTask(client linkedTaskClient, opts beads.CreateOptions, link formLink) (*models.Task, error) {
+	task, err := client.Create(opts)
+	if err != nil || link.targetID == "" {
+		return task, err
+	}
+
+	if err := link.addDependency(client, task.ID); err != nil {
+		err = fmt.Errorf("failed to link %s as %s of %s: %w", task.ID, link.kind, link.targetID, err)
+		if delErr := client.Delete(task.ID); delErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("rollback of %s failed: %w", task.ID, delErr))
+		}
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// openLinkKindModal asks how the new issue should relate to the selected one
+func (m *Model) openLinkKindModal(task *models.Task) {
+	options := []ui.ModalOption{
+		{Label: "Blocker (new issue blocks " + task.ID + ")", Value: string(linkBlocker), Shortcut: "b"},
+		{Label: "Follow-up (new issue is blocked by " + task.ID + ")", Value: string(linkFollowUp), Shortcut: "f"},
+		{Label: "Child (new issue is a child of " + task.ID + ")", Value: string(linkChild), Shortcut: "c"},
+	}
+	m.modal = ui.NewModalSelect("Create Linked Issue", task.ID, options, "")
+	m.mode = ViewLinkKind
+}
+
+func (m *Model) handleLinkKindKeys(msg tea.KeyMsg) tea.Cmd {
+	keyStr := msg.String()
+	if !m.modal.SelectByShortcut(keyStr) {
+		switch keyStr {
+		case "k", "up":
+			m.modal.MoveUp()
+			return nil
+		case "j", "down":
+			m.modal.MoveDown()
+			return nil
+		case "enter":
+		default:
+			return nil
+		}
+	}
+
+	if m.selected == nil {
+		m.mode = ViewList
+		return nil
+	}
+	m.startLinkedForm(m.selected, linkKind(m.modal.SelectedValue()))
+	return nil
+}
+
+// startLinkedForm opens the create form pre-linked to the target issue. The
+// new issue inherits the target's labels and priority.
+func (m *Model) startLinkedForm(target *models.Task, kind linkKind) {
+	m.resetForm()
+	m.editing = false
+	m.formLink = formLink{kind: kind, targetID: target.ID}
+	m.formPriority = target.Priority
+	m.formLabels.SetValue(strings.Join(target.Labels, ", "))
+	m.showFormOrTemplates()
+}
@@ -0,0 +1,107 @@
+package app
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"lazybeads/internal/beads"
+	"lazybeads/internal/models"
+)
+
+// fakeLinkClient records the calls made while creating a linked issue
+type fakeLinkClient struct {
+	createErr, linkErr, deleteErr error
+	calls                         []string
+}
+
+func (c *fakeLinkClient) Create(opts beads.CreateOptions) (*models.Task, error) {
+	c.calls = append(c.calls, "create "+opts.Title)
+	if c.createErr != nil {
+		return nil, c.createErr
+	}
+	return &models.Task{ID: "lazybeads-new", Title: opts.Title}, nil
+}
+
+func (c *fakeLinkClient) AddDependency(issueID, dependsOnID, depType string) error {
+	c.calls = append(c.calls, "link "+issueID+" "+dependsOnID+" "+depType)
+	return c.linkErr
+}
+
+func (c *fakeLinkClient) Delete(id string) error {
+	c.calls = append(c.calls, "delete "+id)
+	return c.deleteErr
+}
+
+func TestCreateLinkedTask(t *testing.T) {
+	failed := errors.New("bd failed")
+	tests := []struct {
+		name    string
+		client  fakeLinkClient
+		link    formLink
+		calls   string
+		created bool
+		errs    []string // parts of the error, none for success
+	}{
+		{
+			name:    "unlinked",
+			calls:   "create Fix",
+			created: true,
+		},
+		{
+			name:    "blocker links the target to the new issue",
+			link:    formLink{kind: linkBlocker, targetID: "lazybeads-1"},
+			calls:   "create Fix, link lazybeads-1 lazybeads-new blocks",
+			created: true,
+		},
+		{
+			name:    "child links the new issue to its parent",
+			link:    formLink{kind: linkChild, targetID: "lazybeads-1"},
+			calls:   "create Fix, link lazybeads-new lazybeads-1 parent-child",
+			created: true,
+		},
+		{
+			name:   "failed create is not linked",
+			client: fakeLinkClient{createErr: failed},
+			link:   formLink{kind: linkFollowUp, targetID: "lazybeads-1"},
+			calls:  "create Fix",
+			errs:   []string{"bd failed"},
+		},
+		{
+			name:   "failed link deletes the new issue",
+			client: fakeLinkClient{linkErr: failed},
+			link:   formLink{kind: linkFollowUp, targetID: "lazybeads-1"},
+			calls:  "create Fix, link lazybeads-new lazybeads-1 blocks, delete lazybeads-new",
+			errs:   []string{"failed to link lazybeads-new as follow-up of lazybeads-1", "bd failed"},
+		},
+		{
+			name:   "failed rollback is reported too",
+			client: fakeLinkClient{linkErr: failed, deleteErr: errors.New("delete refused")},
+			link:   formLink{kind: linkChild, targetID: "lazybeads-1"},
+			calls:  "create Fix, link lazybeads-new lazybeads-1 parent-child, delete lazybeads-new",
+			errs:   []string{"failed to link", "rollback of lazybeads-new failed: delete refused"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := tt.client
+			task, err := createLinkedTask(&client, beads.CreateOptions{Title: "Fix"}, tt.link)
+
+			if got := strings.Join(client.calls, ", "); got != tt.calls {
+				t.Errorf("expected calls %q, got %q", tt.calls, got)
+			}
+			if (task != nil) != tt.created {
+				t.Errorf("expected created %v, got %+v", tt.created, task)
+			}
+			if len(tt.errs) == 0 && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+			for _, part := range tt.errs {
+				if err == nil || !strings.Contains(err.Error(), part) {
+					t.Errorf("expected an error containing %q, got %v", part, err)
+				}
+			}
+		})
+	}
+}
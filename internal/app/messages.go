@@ -3,6 +3,7 @@ package app
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -22,23 +23,29 @@ type tasksLoadedMsg struct {
 
 // taskCreatedMsg is sent when a task is created
 type taskCreatedMsg struct {
-	task *models.Task
-	err  error
+	task     *models.Task
+	parentID string // set when the task was created as a child, to expand the parent
+	linkedID string // the issue the new one was linked to, if any
+	err      error
 }
 
 // taskUpdatedMsg is sent when a task is updated
 type taskUpdatedMsg struct {
-	err error
+	taskID  string
+	changes []string // fields changed by the edit form, for the status summary
+	err     error
 }
 
 // taskClosedMsg is sent when a task is closed
 type taskClosedMsg struct {
-	err error
+	taskID string
+	err    error
 }
 
 // taskDeletedMsg is sent when a task is deleted
 type taskDeletedMsg struct {
-	err error
+	taskID string
+	err    error
 }
 
 // editorFinishedMsg is sent when external editor completes
@@ -66,6 +73,14 @@ func pollTick() tea.Cmd {
 	})
 }
 
+// flashStatus shows a status bar message that clears itself
+func (m *Model) flashStatus(text string) tea.Cmd {
+	m.statusMsg = text
+	return tea.Tick(statusFlashDuration, func(t time.Time) tea.Msg {
+		return clearStatusMsg{}
+	})
+}
+
 // loadTasks creates a command to load all tasks
 func (m Model) loadTasks() tea.Cmd {
 	return func() tea.Msg {
@@ -77,6 +92,7 @@ func (m Model) loadTasks() tea.Cmd {
 
 		tasks, err = enrichDeferredTasks(tasks, m.client)
 		tasks, err = enrichBlockedTasks(tasks, m.client, err)
+		tasks, err = enrichEpicChildren(tasks, m.client, m.epicChildren, err)
 		return tasksLoadedMsg{tasks: tasks, err: err}
 	}
 }
@@ -116,6 +132,101 @@ func enrichDeferredTasks(tasks []models.Task, client *beads.Client) ([]models.Ta
 	return tasks, firstErr
 }
 
+// epicChildCache keeps the children fetched per epic between polls, as
+// long as the issue list has not changed
+type epicChildCache struct {
+	mu        sync.Mutex
+	signature string
+	children  map[string][]models.Task // by epic ID
+}
+
+func (c *epicChildCache) get(signature string) (map[string][]models.Task, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.children, c.children != nil && c.signature == signature
+}
+
+func (c *epicChildCache) set(signature string, children map[string][]models.Task) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.signature, c.children = signature, children
+}
+
+// taskListSignature changes whenever an issue is added, removed or updated
+func taskListSignature(tasks []models.Task) string {
+	var latest time.Time
+	for _, task := range tasks {
+		if task.UpdatedAt.After(latest) {
+			latest = task.UpdatedAt
+		}
+	}
+	return fmt.Sprintf("%d %s", len(tasks), latest.Format(time.RFC3339Nano))
+}
+
+// hasParentDependencies reports whether the listing carries parent-child
+// dependencies, so ParentID already knows every parent
+func hasParentDependencies(tasks []models.Task) bool {
+	for _, task := range tasks {
+		for _, dep := range task.Dependencies {
+			if dep.Type == models.DependencyParentChild {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// enrichEpicChildren sets the parent of epic children when the listing
+// doesn't carry it. Children are fetched for every epic, closed ones
+// included for their progress, and only again once the issue list changes.
+func enrichEpicChildren(tasks []models.Task, client *beads.Client, cache *epicChildCache, prevErr error) ([]models.Task, error) {
+	if hasParentDependencies(tasks) {
+		return tasks, prevErr
+	}
+
+	signature := taskListSignature(tasks)
+	byEpic, cached := cache.get(signature)
+	errs := []error{prevErr}
+	if !cached {
+		byEpic = make(map[string][]models.Task)
+		fetchErrs := 0
+		for _, task := range tasks {
+			if !task.IsEpic() {
+				continue
+			}
+			// TODO: Drop the per-epic fetch once `bd list --json` includes the parent.
+			children, err := client.Children(task.ID)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to load children of %s: %w", task.ID, err))
+				fetchErrs++
+				continue
+			}
+			byEpic[task.ID] = children
+		}
+		if fetchErrs == 0 {
+			cache.set(signature, byEpic)
+		}
+	}
+
+	indexByID := make(map[string]int, len(tasks))
+	for i, task := range tasks {
+		indexByID[task.ID] = i
+	}
+	for epicID, children := range byEpic {
+		for _, child := range children {
+			if idx, ok := indexByID[child.ID]; ok {
+				tasks[idx].Parent = epicID
+			} else {
+				child.Parent = epicID
+				indexByID[child.ID] = len(tasks)
+				tasks = append(tasks, child)
+			}
+		}
+	}
+
+	return tasks, errors.Join(errs...)
+}
+
 func enrichBlockedTasks(tasks []models.Task, client *beads.Client, prevErr error) ([]models.Task, error) {
 	blocked, err := client.Blocked()
 	if err != nil {
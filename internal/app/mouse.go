@@ -0,0 +1,175 @@
+package app
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/ui"
+)
+
+const (
+	// doubleClickInterval is the longest gap between two clicks on an issue
+	// that opens its detail view
+	doubleClickInterval = 400 * time.Millisecond
+
+	// wheelDetailLines is how far one wheel step scrolls the detail pane
+	wheelDetailLines = 3
+)
+
+// handleMouse routes a mouse event to the view under the pointer
+func (m *Model) handleMouse(msg tea.MouseMsg) tea.Cmd {
+	switch {
+	case m.mode == ViewForm:
+		return m.handleFormMouse(msg)
+	case isModalMode(m.mode):
+		return m.handleModalMouse(msg)
+	case m.mode == ViewList || m.mode == ViewDetail || m.mode == ViewChecklist:
+		return m.handleMainMouse(msg)
+	}
+	return nil
+}
+
+// handleModalMouse scrolls through select options and picks the clicked one
+func (m *Model) handleModalMouse(msg tea.MouseMsg) tea.Cmd {
+	if m.modal.Type != ui.ModalSelect || msg.Action != tea.MouseActionPress {
+		return nil
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		m.modal.MoveUp()
+	case tea.MouseButtonWheelDown:
+		m.modal.MoveDown()
+	case tea.MouseButtonLeft:
+		if i := m.modal.OptionAt(m.width, m.height, msg.X, msg.Y); i >= 0 {
+			m.modal.Selected = i
+			// Same as confirming the option with enter
+			return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+		}
+	}
+	return nil
+}
+
+// handleMainMouse handles the panels, the detail pane and the border
+// between them
+func (m *Model) handleMainMouse(msg tea.MouseMsg) tea.Cmd {
+	if m.resizingPanels {
+		switch msg.Action {
+		case tea.MouseActionMotion:
+			m.resizePanels(msg.X)
+		case tea.MouseActionRelease:
+			m.resizingPanels = false
+		}
+		return nil
+	}
+	if msg.Action != tea.MouseActionPress {
+		return nil
+	}
+
+	wide := m.width >= wideModeMinWidth
+	overDetail := wide && msg.X >= m.panelWidth
+	// The narrow detail overlay and the checklist cover the whole screen
+	if (m.mode == ViewDetail && !wide) || m.mode == ViewChecklist {
+		overDetail = true
+	}
+
+	if tea.MouseEvent(msg).IsWheel() {
+		lines := 1
+		if msg.Button == tea.MouseButtonWheelUp {
+			lines = -1
+		}
+		if overDetail {
+			m.scrollDetail(lines * wheelDetailLines)
+			return nil
+		}
+		if focus, _, ok := m.panelAt(msg.Y); ok {
+			if focus != m.focusedPanel {
+				m.setPanelFocus(focus)
+			}
+			m.panel(focus).MoveCursor(lines)
+			m.selected = m.getSelectedTask()
+		}
+		return nil
+	}
+
+	if msg.Button != tea.MouseButtonLeft {
+		return nil
+	}
+
+	// Either side of the border between the panels and the detail pane
+	// starts a drag
+	if wide && m.mode != ViewChecklist && (msg.X == m.panelWidth-1 || msg.X == m.panelWidth) && msg.Y < m.height-2 {
+		m.resizingPanels = true
+		return nil
+	}
+
+	if overDetail {
+		if m.mode == ViewList && m.selected != nil {
+			m.updateDetailContent()
+			m.mode = ViewDetail
+		}
+		return nil
+	}
+
+	focus, row, ok := m.panelAt(msg.Y)
+	if !ok {
+		return nil
+	}
+	m.clickPanel(focus, row)
+	return nil
+}
+
+// panelAt returns the panel drawn on a screen row and the row within it
+func (m *Model) panelAt(y int) (PanelFocus, int, bool) {
+	top := 0
+	for _, focus := range m.getVisiblePanels() {
+		height := m.panel(focus).height
+		if y >= top && y < top+height {
+			return focus, y - top, true
+		}
+		top += height
+	}
+	return 0, 0, false
+}
+
+// clickPanel focuses a panel and selects the clicked issue; a second click
+// on the same issue opens its detail view
+func (m *Model) clickPanel(focus PanelFocus, row int) {
+	// Find the issue before focusing, which may resize the panels
+	idx := m.panel(focus).ItemAt(row)
+
+	if m.mode == ViewDetail {
+		m.mode = ViewList
+	}
+	if focus != m.focusedPanel {
+		m.setPanelFocus(focus)
+	}
+	if idx < 0 {
+		m.lastClickID = ""
+		return
+	}
+
+	m.panel(focus).Select(idx)
+	m.selected = m.getSelectedTask()
+	if m.selected == nil {
+		return
+	}
+
+	now := time.Now()
+	if m.lastClickID == m.selected.ID && now.Sub(m.lastClickAt) <= doubleClickInterval {
+		m.lastClickID = ""
+		m.updateDetailContent()
+		m.mode = ViewDetail
+		return
+	}
+	m.lastClickID = m.selected.ID
+	m.lastClickAt = now
+}
+
+// resizePanels moves the border between the panels and the detail pane to
+// screen column x, within the limits of the layout
+func (m *Model) resizePanels(x int) {
+	m.panelAdjust += x + 1 - m.panelWidth
+	m.updateSizes()
+}
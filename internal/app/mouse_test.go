@@ -0,0 +1,118 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+var mouseTestTasks = []models.Task{
+	{ID: "lazybeads-1", Title: "Doing", Status: "in_progress"},
+	{ID: "lazybeads-2", Title: "First", Status: "open"},
+	{ID: "lazybeads-3", Title: "Second", Status: "open", Description: strings.Repeat("line\n\n", 60)},
+}
+
+func click(m Model, x, y int) Model {
+	updated, _ := m.Update(tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	return updated.(Model)
+}
+
+// screenRow returns the first row of the rendered view containing text
+func screenRow(t *testing.T, m Model, text string) int {
+	t.Helper()
+	for i, line := range strings.Split(m.View(), "\n") {
+		if strings.Contains(line, text) {
+			return i
+		}
+	}
+	t.Fatalf("%q not on screen", text)
+	return -1
+}
+
+func TestMouseClickSelectsAndOpensIssue(t *testing.T) {
+	m := newTestModel(t, mouseTestTasks...)
+
+	row := screenRow(t, m, "Second")
+	m = click(m, 5, row)
+	if m.focusedPanel != FocusOpen || m.selected == nil || m.selected.ID != "lazybeads-3" {
+		t.Fatalf("expected lazybeads-3 selected in the open panel, got %v %+v", m.focusedPanel, m.selected)
+	}
+	if m.mode != ViewList {
+		t.Fatalf("expected a single click to stay in the list, got mode %v", m.mode)
+	}
+
+	m = click(m, 5, row)
+	if m.mode != ViewDetail {
+		t.Errorf("expected a double click to open the detail view, got mode %v", m.mode)
+	}
+
+	// Clicking a panel from the detail view returns to the list
+	m = click(m, 5, screenRow(t, m, "Doing"))
+	if m.mode != ViewList || m.focusedPanel != FocusInProgress || m.selected.ID != "lazybeads-1" {
+		t.Errorf("expected lazybeads-1 selected in the list, got mode %v %+v", m.mode, m.selected)
+	}
+}
+
+func TestMouseWheelScrollsPanelsAndDetail(t *testing.T) {
+	m := newTestModel(t, mouseTestTasks...)
+	row := screenRow(t, m, "First")
+	m = click(m, 5, row)
+
+	updated, _ := m.Update(tea.MouseMsg{X: 5, Y: row, Action: tea.MouseActionPress, Button: tea.MouseButtonWheelDown})
+	m = updated.(Model)
+	if m.selected == nil || m.selected.ID != "lazybeads-3" {
+		t.Fatalf("expected the wheel to move to lazybeads-3, got %+v", m.selected)
+	}
+
+	m.setDetailTab(detailTabText)
+	updated, _ = m.Update(tea.MouseMsg{X: m.panelWidth + 5, Y: 10, Action: tea.MouseActionPress, Button: tea.MouseButtonWheelDown})
+	m = updated.(Model)
+	if m.detail.YOffset != wheelDetailLines {
+		t.Errorf("expected the detail pane scrolled by %d, got %d", wheelDetailLines, m.detail.YOffset)
+	}
+}
+
+func TestMouseDragResizesPanels(t *testing.T) {
+	m := newTestModel(t, mouseTestTasks...)
+	before := m.panelWidth
+
+	m = click(m, before-1, 5)
+	updated, _ := m.Update(tea.MouseMsg{X: before + 9, Y: 5, Action: tea.MouseActionMotion, Button: tea.MouseButtonLeft})
+	m = updated.(Model)
+	updated, _ = m.Update(tea.MouseMsg{X: before + 9, Y: 5, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft})
+	m = updated.(Model)
+
+	if m.panelWidth != before+10 {
+		t.Errorf("expected panel width %d, got %d", before+10, m.panelWidth)
+	}
+	if m.resizingPanels {
+		t.Error("expected the drag to end on release")
+	}
+}
+
+func TestMouseClickModalOption(t *testing.T) {
+	m := newTestModel(t, mouseTestTasks...)
+	m = click(m, 5, screenRow(t, m, "First"))
+
+	m.modal = ui.NewModalSelect("Edit Status", "lazybeads-2", []ui.ModalOption{
+		{Label: "Open", Value: "open", Shortcut: "o"},
+		{Label: "In Progress", Value: "in_progress", Shortcut: "i"},
+		{Label: "Closed", Value: "closed", Shortcut: "c"},
+	}, "open")
+	m.mode = ViewEditStatus
+
+	row := screenRow(t, m, "[c] Closed")
+	if got := m.modal.OptionAt(m.width, m.height, 0, row); got != -1 {
+		t.Errorf("expected no option left of the modal, got %d", got)
+	}
+
+	updated, cmd := m.Update(tea.MouseMsg{X: m.width / 2, Y: row, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	m = updated.(Model)
+	if m.modal.SelectedValue() != "closed" || m.mode != ViewList || cmd == nil {
+		t.Errorf("expected the closed option applied, got %q in mode %v", m.modal.SelectedValue(), m.mode)
+	}
+}
@@ -0,0 +1,360 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"lazybeads/internal/config"
+	"lazybeads/internal/ui"
+)
+
+// paletteRecentMax is how many recently run entries the palette remembers
+const paletteRecentMax = 8
+
+type paletteKind int
+
+const (
+	paletteAction       paletteKind = iota // built-in binding of the issue list
+	paletteDetailAction                    // built-in binding only in the detail view
+	paletteCustom
+	paletteView
+	paletteIssue
+)
+
+// paletteTags label each kind of entry in the palette
+var paletteTags = map[paletteKind]string{
+	paletteAction:       "action",
+	paletteDetailAction: "detail",
+	paletteCustom:       "command",
+	paletteView:         "view",
+	paletteIssue:        "issue",
+}
+
+// paletteHiddenActions are bindings that make no sense to run from the
+// palette: cursor movement, scrolling and the palette itself
+var paletteHiddenActions = map[string]bool{
+	"up": true, "down": true, "top": true, "bottom": true, "pageUp": true, "pageDown": true,
+	"detailDown": true, "detailUp": true, "palette": true, "quit": true, "cancel": true,
+}
+
+// paletteItem is one entry of the command palette
+type paletteItem struct {
+	id    string // stable across openings, to remember recent entries
+	kind  paletteKind
+	title string
+	hint  string // key, issue ID or filter shown on the right
+
+	action  string
+	command config.CustomCommand
+	filter  string
+	taskID  string
+}
+
+func (p paletteItem) filterValue() string {
+	return p.title + " " + p.hint
+}
+
+// buildPaletteItems lists every entry the palette can run, with recently
+// used ones first
+func (m *Model) buildPaletteItems() []paletteItem {
+	var items []paletteItem
+	addAction := func(kind paletteKind, keys ui.KeyMap, action string) {
+		binding := keys.Binding(action)
+		help := binding.Help()
+		if paletteHiddenActions[action] || !binding.Enabled() || help.Desc == "" {
+			return
+		}
+		items = append(items, paletteItem{
+			id:     "action:" + action,
+			kind:   kind,
+			title:  help.Desc,
+			hint:   help.Key,
+			action: action,
+		})
+	}
+
+	for _, action := range listActions {
+		addAction(paletteAction, m.listKeys, action)
+	}
+	for _, action := range detailActions {
+		if !containsString(listActions, action) {
+			addAction(paletteDetailAction, m.detailKeys, action)
+		}
+	}
+	for _, cmd := range m.customCommands {
+		items = append(items, paletteItem{
+			id:      "command:" + cmd.Key + ":" + cmd.Description,
+			kind:    paletteCustom,
+			title:   cmd.Description,
+			hint:    cmd.Key,
+			command: cmd,
+		})
+	}
+	for _, view := range m.savedViews {
+		items = append(items, paletteItem{
+			id:     "view:" + view.Name,
+			kind:   paletteView,
+			title:  view.Name,
+			hint:   view.Filter,
+			filter: view.Filter,
+		})
+	}
+	for _, task := range m.tasks {
+		items = append(items, paletteItem{
+			id:     "issue:" + task.ID,
+			kind:   paletteIssue,
+			title:  task.Title,
+			hint:   task.ID,
+			taskID: task.ID,
+		})
+	}
+
+	// Recently run entries that still exist go first, most recent on top
+	var recent, rest []paletteItem
+	byID := make(map[string]paletteItem, len(items))
+	for _, item := range items {
+		byID[item.id] = item
+	}
+	for _, id := range m.paletteRecent {
+		if item, ok := byID[id]; ok {
+			recent = append(recent, item)
+			delete(byID, id)
+		}
+	}
+	for _, item := range items {
+		if _, ok := byID[item.id]; ok {
+			rest = append(rest, item)
+		}
+	}
+	return append(recent, rest...)
+}
+
+// filterPaletteItems ranks items by fuzzy match; an empty query keeps
+// their order
+func filterPaletteItems(items []paletteItem, query string) []paletteItem {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return items
+	}
+	targets := make([]string, len(items))
+	for i, item := range items {
+		targets[i] = item.filterValue()
+	}
+	ranks := list.DefaultFilter(query, targets)
+	filtered := make([]paletteItem, len(ranks))
+	for i, rank := range ranks {
+		filtered[i] = items[rank.Index]
+	}
+	return filtered
+}
+
+// openPalette shows the command palette over the current view
+func (m *Model) openPalette() tea.Cmd {
+	m.paletteReturn = m.mode
+	m.paletteItems = m.buildPaletteItems()
+	m.paletteInput.SetValue("")
+	m.applyPaletteFilter()
+	m.mode = ViewPalette
+	return m.paletteInput.Focus()
+}
+
+func (m *Model) applyPaletteFilter() {
+	m.paletteMatches = filterPaletteItems(m.paletteItems, m.paletteInput.Value())
+	m.paletteCursor = 0
+}
+
+// closePalette returns to the view the palette was opened from
+func (m *Model) closePalette() {
+	m.paletteInput.Blur()
+	m.mode = m.paletteReturn
+}
+
+func (m *Model) handlePaletteKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "up", "ctrl+k":
+		if m.paletteCursor > 0 {
+			m.paletteCursor--
+		}
+		return nil
+	case "down", "ctrl+j":
+		if m.paletteCursor < len(m.paletteMatches)-1 {
+			m.paletteCursor++
+		}
+		return nil
+	case "enter":
+		if m.paletteCursor >= len(m.paletteMatches) {
+			return nil
+		}
+		item := m.paletteMatches[m.paletteCursor]
+		m.closePalette()
+		return m.runPaletteItem(item)
+	}
+
+	prevValue := m.paletteInput.Value()
+	var cmd tea.Cmd
+	m.paletteInput, cmd = m.paletteInput.Update(msg)
+	if prevValue != m.paletteInput.Value() {
+		m.applyPaletteFilter()
+	}
+	return cmd
+}
+
+// runPaletteItem runs an entry in the context it belongs to: list actions
+// in the issue list, detail actions in the detail view, and actions found
+// in both where the palette was opened
+func (m *Model) runPaletteItem(item paletteItem) tea.Cmd {
+	m.rememberPaletteItem(item.id)
+
+	switch item.kind {
+	case paletteAction:
+		if m.paletteReturn == ViewDetail && containsString(detailActions, item.action) {
+			return m.runDetailAction(item.action)
+		}
+		m.mode = ViewList
+		return m.handleListKeys(keyMsgFromString(firstBindingKey(m.listKeys.Binding(item.action).Keys())))
+	case paletteDetailAction:
+		return m.runDetailAction(item.action)
+	case paletteCustom:
+		switch item.command.Context {
+		case "list":
+			m.mode = ViewList
+		case "detail":
+			if m.selected != nil {
+				m.mode = ViewDetail
+				m.updateDetailContent()
+			}
+		}
+		return m.executeCustomCommand(item.command)
+	case paletteView:
+		m.mode = ViewList
+		m.filterQuery = item.filter
+		m.searchInput.SetValue(item.filter)
+		m.distributeTasks()
+		m.selected = m.getSelectedTask()
+		return m.flashStatus("View: " + item.title)
+	case paletteIssue:
+		if !m.revealTask(item.taskID) {
+			m.err = fmt.Errorf("issue %s is not in any panel", item.taskID)
+		}
+	}
+	return nil
+}
+
+// runDetailAction runs a detail view binding on the selected issue
+func (m *Model) runDetailAction(action string) tea.Cmd {
+	if m.selected == nil {
+		m.mode = ViewList
+		return nil
+	}
+	m.mode = ViewDetail
+	m.updateDetailContent()
+	return m.handleDetailKeys(keyMsgFromString(firstBindingKey(m.detailKeys.Binding(action).Keys())))
+}
+
+// rememberPaletteItem moves an entry to the front of the recent list
+func (m *Model) rememberPaletteItem(id string) {
+	recent := []string{id}
+	for _, other := range m.paletteRecent {
+		if other != id && len(recent) < paletteRecentMax {
+			recent = append(recent, other)
+		}
+	}
+	m.paletteRecent = recent
+}
+
+func (m Model) viewPalette() string {
+	var b strings.Builder
+
+	width, height := helpModalSize(m.width, m.height)
+	innerWidth := width - 2
+	rows := height - 4 // title, input, blank line and help bar
+	if rows < 1 {
+		rows = 1
+	}
+
+	b.WriteString(ui.TitleStyle.Render("Command Palette"))
+	b.WriteString("\n")
+	b.WriteString(ui.HelpKeyStyle.Render("> ") + m.paletteInput.View())
+	b.WriteString("\n\n")
+
+	start := 0
+	if m.paletteCursor >= rows {
+		start = m.paletteCursor - rows + 1
+	}
+	end := min(start+rows, len(m.paletteMatches))
+	recent := make(map[string]bool, len(m.paletteRecent))
+	for _, id := range m.paletteRecent {
+		recent[id] = true
+	}
+	for i := start; i < end; i++ {
+		b.WriteString(renderPaletteRow(m.paletteMatches[i], innerWidth, i == m.paletteCursor, recent[m.paletteMatches[i].id]))
+		b.WriteString("\n")
+	}
+	if len(m.paletteMatches) == 0 {
+		b.WriteString(ui.HelpDescStyle.Render("No matches"))
+		b.WriteString("\n")
+	}
+	for i := max(end-start, 1); i < rows; i++ {
+		b.WriteString("\n")
+	}
+
+	helpParts := []string{
+		ui.HelpKeyStyle.Render("up/down") + ":" + ui.HelpDescStyle.Render("move"),
+		ui.HelpKeyStyle.Render("enter") + ":" + ui.HelpDescStyle.Render("run"),
+		ui.HelpKeyStyle.Render("esc") + ":" + ui.HelpDescStyle.Render("close"),
+		ui.HelpDescStyle.Render(fmt.Sprintf("(%d of %d)", len(m.paletteMatches), len(m.paletteItems))),
+	}
+	b.WriteString(ui.HelpBarStyle.Render(strings.Join(helpParts, "  ")))
+
+	modal := ui.OverlayStyle.Padding(0, 1).
+		Width(width).
+		Height(height).
+		Render(b.String())
+
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		modal,
+	)
+}
+
+// renderPaletteRow draws "tag  title   hint" across the palette width
+func renderPaletteRow(item paletteItem, width int, selected, recent bool) string {
+	tag := paletteTags[item.kind]
+	if recent {
+		tag = "recent"
+	}
+	marker := ""
+	if ui.NoColor() {
+		// Selection is shown as a marker without colors
+		marker = "  "
+		if selected {
+			marker = "> "
+		}
+	}
+
+	tagText := fmt.Sprintf("%-8s", tag)
+	hint := item.hint
+	titleWidth := width - lipgloss.Width(marker) - lipgloss.Width(tagText) - lipgloss.Width(hint) - 2
+	title := item.title
+	if titleWidth < 1 {
+		titleWidth = 1
+	}
+	title = truncateTitle(title, titleWidth)
+	gap := strings.Repeat(" ", max(width-lipgloss.Width(marker)-lipgloss.Width(tagText)-lipgloss.Width(title)-lipgloss.Width(hint), 1))
+
+	if selected {
+		style := lipgloss.NewStyle().
+			Foreground(ui.ColorSelectionText).
+			Background(ui.ColorSelection).
+			Bold(true).
+			Width(width)
+		return style.Render(marker + tagText + title + gap + hint)
+	}
+	mutedStyle := lipgloss.NewStyle().Foreground(ui.ColorMuted)
+	return marker + mutedStyle.Render(tagText) + ui.DetailValueStyle.Render(title) + gap + ui.HelpKeyStyle.Render(hint)
+}
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/config"
+	"lazybeads/internal/models"
+)
+
+var paletteTestTasks = []models.Task{
+	{ID: "lazybeads-1", Title: "Fix auth tokens", Status: "open"},
+	{ID: "lazybeads-2", Title: "Write docs", Status: "open"},
+	{ID: "lazybeads-3", Title: "Old release", Status: "closed"},
+}
+
+var paletteTestViews = []config.SavedView{{Name: "Auth work", Filter: "auth"}}
+
+// typePalette opens the palette and types query into it
+func typePalette(m Model, query string) Model {
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	m = updated.(Model)
+	for _, r := range query {
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = updated.(Model)
+	}
+	return m
+}
+
+func pressEnter(m Model) Model {
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	return updated.(Model)
+}
+
+func TestPaletteListsEveryKindOfEntry(t *testing.T) {
+	m := newTestModel(t, paletteTestTasks...)
+	m.savedViews = paletteTestViews
+	m = typePalette(m, "")
+	if m.mode != ViewPalette {
+		t.Fatalf("expected ctrl+p to open the palette, got mode %v", m.mode)
+	}
+
+	kinds := make(map[paletteKind]bool)
+	for _, item := range m.paletteItems {
+		kinds[item.kind] = true
+		if item.action == "up" || item.action == "palette" {
+			t.Errorf("expected %s to be hidden from the palette", item.action)
+		}
+	}
+	for _, kind := range []paletteKind{paletteAction, paletteDetailAction, paletteView, paletteIssue} {
+		if !kinds[kind] {
+			t.Errorf("expected %s entries in the palette", paletteTags[kind])
+		}
+	}
+}
+
+func TestPaletteJumpsToIssueAndRemembersIt(t *testing.T) {
+	m := newTestModel(t, paletteTestTasks...)
+	m.savedViews = paletteTestViews
+	m = typePalette(m, "old rel")
+	if len(m.paletteMatches) == 0 || m.paletteMatches[0].taskID != "lazybeads-3" {
+		t.Fatalf("expected lazybeads-3 ranked first, got %+v", m.paletteMatches)
+	}
+
+	m = pressEnter(m)
+	if m.mode != ViewList || m.selected == nil || m.selected.ID != "lazybeads-3" || m.focusedPanel != FocusClosed {
+		t.Fatalf("expected lazybeads-3 selected in the closed panel, got mode %v %+v", m.mode, m.selected)
+	}
+
+	m = typePalette(m, "")
+	if len(m.paletteMatches) == 0 || m.paletteMatches[0].id != "issue:lazybeads-3" {
+		t.Errorf("expected the recent issue listed first, got %+v", m.paletteMatches[0])
+	}
+}
+
+func TestPaletteRunsActionsInTheirContext(t *testing.T) {
+	m := newTestModel(t, paletteTestTasks...)
+	m.savedViews = paletteTestViews
+
+	m = pressEnter(typePalette(m, "edit title"))
+	if m.mode != ViewEditTitle {
+		t.Fatalf("expected the edit title modal, got mode %v", m.mode)
+	}
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+
+	// A detail-only action opens the detail view to run in
+	m = pressEnter(typePalette(m, "toggle markdown"))
+	if m.mode != ViewDetail || !m.rawMarkdown {
+		t.Errorf("expected raw markdown in the detail view, got mode %v raw %v", m.mode, m.rawMarkdown)
+	}
+
+	// Esc returns to where the palette was opened
+	m = typePalette(m, "")
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.mode != ViewDetail {
+		t.Errorf("expected esc to return to the detail view, got mode %v", m.mode)
+	}
+}
+
+func TestPaletteAppliesSavedView(t *testing.T) {
+	m := newTestModel(t, paletteTestTasks...)
+	m.savedViews = paletteTestViews
+	m = pressEnter(typePalette(m, "auth work"))
+
+	if m.filterQuery != "auth" || m.openPanel.TaskCount() != 1 {
+		t.Errorf("expected the auth filter to leave one open issue, got %q with %d", m.filterQuery, m.openPanel.TaskCount())
+	}
+}
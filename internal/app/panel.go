@@ -78,14 +78,31 @@ func NewPanel(title string) PanelModel {
 	}
 }
 
-// SetTasks updates the panel's task list
+// SetTasks updates the panel's task list. The cursor stays on the selected
+// task wherever it moves in the list; if the task is gone, it stays at the
+// same position, which now holds a neighbour.
 func (p *PanelModel) SetTasks(tasks []models.Task) {
+	selectedID, selectedIdx := "", p.list.Index()
+	if task := p.SelectedTask(); task != nil {
+		selectedID = task.ID
+	}
+
 	p.tasks = tasks
 	items := make([]list.Item, len(tasks))
 	for i, t := range tasks {
 		items[i] = taskItem{task: t}
 	}
 	p.list.SetItems(items)
+
+	if selectedID != "" && p.SelectTaskByID(selectedID) {
+		return
+	}
+	if selectedIdx >= len(tasks) {
+		selectedIdx = len(tasks) - 1
+	}
+	if selectedIdx >= 0 {
+		p.list.Select(selectedIdx)
+	}
 }
 
 // SetSize updates the panel dimensions
@@ -139,6 +156,46 @@ func (p PanelModel) SelectedTask() *models.Task {
 	return nil
 }
 
+// SelectTaskByID moves the cursor to the task with the given ID
+func (p *PanelModel) SelectTaskByID(id string) bool {
+	for i, task := range p.tasks {
+		if task.ID == id {
+			p.list.Select(i)
+			return true
+		}
+	}
+	return false
+}
+
+// ItemAt returns the index of the task drawn on a row of the panel, counted
+// from its top border, or -1
+func (p PanelModel) ItemAt(row int) int {
+	if p.collapsed || row < 1 || row > p.height-2 {
+		return -1
+	}
+	start, _ := p.list.Paginator.GetSliceBounds(len(p.tasks))
+	idx := start + row - 1
+	if idx >= len(p.tasks) {
+		return -1
+	}
+	return idx
+}
+
+// Select moves the cursor to a task index
+func (p *PanelModel) Select(idx int) {
+	p.list.Select(idx)
+}
+
+// MoveCursor moves the cursor down (positive) or up (negative) by rows
+func (p *PanelModel) MoveCursor(rows int) {
+	for ; rows > 0; rows-- {
+		p.list.CursorDown()
+	}
+	for ; rows < 0; rows++ {
+		p.list.CursorUp()
+	}
+}
+
 // TaskCount returns the number of tasks in this panel
 func (p PanelModel) TaskCount() int {
 	return len(p.tasks)
@@ -225,9 +282,13 @@ func (p PanelModel) View() string {
 
 	borderStyle := lipgloss.NewStyle().Foreground(borderColor)
 	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(titleColor)
+	border := ui.Glyphs.Border
 
-	// Build title with count
+	// Build title with count; without colors focus is marked in the title
 	titleText := fmt.Sprintf(" %s (%d) ", p.title, len(p.tasks))
+	if p.focused && ui.NoColor() {
+		titleText = fmt.Sprintf(" > %s (%d) ", p.title, len(p.tasks))
+	}
 
 	// Truncate title if too long (use lipgloss.Width for proper display width)
 	maxTitleLen := width - 6 // Leave room for corners (╭─ and ─╮) and some border
@@ -246,9 +307,9 @@ func (p PanelModel) View() string {
 	if remainingWidth < 0 {
 		remainingWidth = 0
 	}
-	topBorder := borderStyle.Render("╭─") +
+	topBorder := borderStyle.Render(border.TopLeft+border.Top) +
 		titleStyle.Render(titleText) +
-		borderStyle.Render(strings.Repeat("─", remainingWidth)+"─╮")
+		borderStyle.Render(strings.Repeat(border.Top, remainingWidth)+border.Top+border.TopRight)
 
 	// Build content area
 	contentWidth := width - 4 // -4 for side borders and padding (│ + space on each side)
@@ -292,12 +353,12 @@ func (p PanelModel) View() string {
 			line = lipgloss.NewStyle().Width(contentWidth).MaxWidth(contentWidth).Render(line)
 		}
 		// Add side borders with single space padding
-		row := borderStyle.Render("│") + " " + line + " " + borderStyle.Render("│")
+		row := borderStyle.Render(border.Left) + " " + line + " " + borderStyle.Render(border.Right)
 		middleRows = append(middleRows, row)
 	}
 
 	// Build bottom border: ╰───────────────────╯
-	bottomBorder := borderStyle.Render("╰" + strings.Repeat("─", width-2) + "╯")
+	bottomBorder := borderStyle.Render(border.BottomLeft + strings.Repeat(border.Bottom, width-2) + border.BottomRight)
 
 	// Combine all parts
 	var result strings.Builder
@@ -322,6 +383,7 @@ func (p PanelModel) viewCollapsed() string {
 	titleColor := ui.ColorMuted
 	borderStyle := lipgloss.NewStyle().Foreground(borderColor)
 	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(titleColor)
+	border := ui.Glyphs.Border
 
 	// Build title with count
 	titleText := fmt.Sprintf(" %s (%d) ", p.title, len(p.tasks))
@@ -332,9 +394,9 @@ func (p PanelModel) viewCollapsed() string {
 	if remainingWidth < 0 {
 		remainingWidth = 0
 	}
-	topBorder := borderStyle.Render("╭─") +
+	topBorder := borderStyle.Render(border.TopLeft+border.Top) +
 		titleStyle.Render(titleText) +
-		borderStyle.Render(strings.Repeat("─", remainingWidth)+"─╮")
+		borderStyle.Render(strings.Repeat(border.Top, remainingWidth)+border.Top+border.TopRight)
 
 	// Build content line with first task
 	contentWidth := width - 4 // -4 for side borders and padding
@@ -358,10 +420,10 @@ func (p PanelModel) viewCollapsed() string {
 	}
 
 	// Add side borders
-	middleRow := borderStyle.Render("│") + " " + contentLine + " " + borderStyle.Render("│")
+	middleRow := borderStyle.Render(border.Left) + " " + contentLine + " " + borderStyle.Render(border.Right)
 
 	// Build bottom border: ╰───────────────────╯
-	bottomBorder := borderStyle.Render("╰" + strings.Repeat("─", width-2) + "╯")
+	bottomBorder := borderStyle.Render(border.BottomLeft + strings.Repeat(border.Bottom, width-2) + border.BottomRight)
 
 	return topBorder + "\n" + middleRow + "\n" + bottomBorder
 }
@@ -377,10 +439,12 @@ func formatTaskLine(task models.Task, width int, isSelected bool, focused bool)
 	deferred := task.IsDeferred(now)
 	blocked := task.IsBlocked()
 	stateMarker := " "
-	if blocked {
-		stateMarker = "⛔"
+	if task.Changed {
+		stateMarker = ui.Glyphs.Changed
+	} else if blocked {
+		stateMarker = ui.Glyphs.Blocked
 	} else if deferred {
-		stateMarker = "⏳"
+		stateMarker = ui.Glyphs.Deferred
 	}
 	markerWidth := 2
 	markerPad := markerWidth - lipgloss.Width(stateMarker)
@@ -389,8 +453,26 @@ func formatTaskLine(task models.Task, width int, isSelected bool, focused bool)
 	}
 	markerText := stateMarker + strings.Repeat(" ", markerPad)
 	markerStyle := lipgloss.NewStyle().Foreground(ui.ColorMuted)
-	if deferred || blocked {
+	if task.Changed {
+		markerStyle = markerStyle.Foreground(ui.ColorAccent).Bold(true)
+	}
+	if len(task.Children) > 0 {
+		expander := ui.Glyphs.Collapsed
+		if task.Expanded {
+			expander = ui.Glyphs.Expanded
+		}
+		title = expander + title
+	}
+	progress := epicRowProgress(task)
+	checklist := checklistProgressText(task)
+	if deferred || blocked || progress != "" || checklist != "" {
 		var parts []string
+		if progress != "" {
+			parts = append(parts, fmt.Sprintf("[%s]", progress))
+		}
+		if checklist != "" {
+			parts = append(parts, fmt.Sprintf("[%s %s]", ui.Glyphs.Tick, checklist))
+		}
 		if deferred {
 			parts = append(parts, fmt.Sprintf("(%s)", formatRelativeTime(*task.DeferUntil, now)))
 		}
@@ -438,10 +520,15 @@ func formatTaskLine(task models.Task, width int, isSelected bool, focused bool)
 	}
 
 	if isSelected && focused {
-		// Show highlight only when panel is focused
-		line := fmt.Sprintf(" %s %s %s %s%s", markerText, priority, issueID, displayTitle, suffix)
-		bgColor := lipgloss.Color("#2a4a6d")
-		fgColor := lipgloss.Color("15")
+		// Show highlight only when panel is focused; without colors a
+		// leading marker shows the selection
+		lead := " "
+		if ui.NoColor() {
+			lead = ">"
+		}
+		line := fmt.Sprintf("%s%s %s %s %s%s", lead, markerText, priority, issueID, displayTitle, suffix)
+		bgColor := ui.ColorSelection
+		fgColor := ui.ColorSelectionText
 		faint := false
 		if deferred {
 			bgColor = deferredStyle.FocusedBackground
@@ -460,6 +547,9 @@ func formatTaskLine(task models.Task, width int, isSelected bool, focused bool)
 	priorityStyle := ui.PriorityStyle(task.Priority)
 	idStyle := lipgloss.NewStyle().Foreground(ui.ColorMuted)
 	titleStyle := lipgloss.NewStyle().Foreground(ui.ColorWhite)
+	if task.Changed {
+		titleStyle = titleStyle.Foreground(ui.ColorAccent)
+	}
 	suffixStyle := lipgloss.NewStyle().Foreground(ui.ColorMuted)
 
 	if deferred {
@@ -487,10 +577,11 @@ func truncateTitle(title string, maxWidth int) string {
 	if lipgloss.Width(title) <= maxWidth {
 		return title
 	}
-	for lipgloss.Width(title+"...") > maxWidth && len(title) > 0 {
-		title = title[:len(title)-1]
+	runes := []rune(title)
+	for lipgloss.Width(string(runes)+"...") > maxWidth && len(runes) > 0 {
+		runes = runes[:len(runes)-1]
 	}
-	return title + "..."
+	return string(runes) + "..."
 }
 
 func shortenIssueID(id string) string {
@@ -563,21 +654,15 @@ func deferredStyleConfig(styleName deferredStyleName) deferStyleConfig {
 	switch styleName {
 	case deferredStyleAmber:
 		return deferStyleConfig{
-			FocusedBackground: lipgloss.Color("#5a4a00"),
-			FocusedForeground: lipgloss.Color("15"),
-			MarkerColor:       lipgloss.Color("#c9a400"),
-		}
-	case deferredStyleSlate:
-		return deferStyleConfig{
-			FocusedBackground: lipgloss.Color("#3a3f45"),
-			FocusedForeground: lipgloss.Color("15"),
-			MarkerColor:       lipgloss.Color("#9aa0a6"),
+			FocusedBackground: ui.ColorAmberBg,
+			FocusedForeground: ui.ColorSelectionText,
+			MarkerColor:       ui.ColorAmber,
 		}
 	default:
 		return deferStyleConfig{
-			FocusedBackground: lipgloss.Color("#3a3f45"),
-			FocusedForeground: lipgloss.Color("15"),
-			MarkerColor:       lipgloss.Color("#9aa0a6"),
+			FocusedBackground: ui.ColorSlateBg,
+			FocusedForeground: ui.ColorSelectionText,
+			MarkerColor:       ui.ColorSlate,
 		}
 	}
 }
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"testing"
+
+	"lazybeads/internal/models"
+)
+
+func TestPanelKeepsSelectionByID(t *testing.T) {
+	p := NewPanel("Open")
+	p.SetSize(40, 20)
+	p.SetTasks([]models.Task{{ID: "a"}, {ID: "b"}, {ID: "c"}})
+	p.SelectTaskByID("b")
+
+	// A new issue above moves the selected one down
+	p.SetTasks([]models.Task{{ID: "new"}, {ID: "a"}, {ID: "b"}, {ID: "c"}})
+	if got := p.SelectedTask(); got == nil || got.ID != "b" {
+		t.Fatalf("expected b to stay selected, got %+v", got)
+	}
+
+	// When it leaves, the neighbour that took its place is selected
+	p.SetTasks([]models.Task{{ID: "new"}, {ID: "a"}, {ID: "c"}})
+	if got := p.SelectedTask(); got == nil || got.ID != "c" {
+		t.Fatalf("expected neighbour c, got %+v", got)
+	}
+
+	// At the end of the list, the one before it
+	p.SetTasks([]models.Task{{ID: "new"}, {ID: "a"}})
+	if got := p.SelectedTask(); got == nil || got.ID != "a" {
+		t.Errorf("expected neighbour a, got %+v", got)
+	}
+}
+
+func TestSelectionFollowsIssueToItsNewPanel(t *testing.T) {
+	m := newTestModel(t,
+		models.Task{ID: "lazybeads-1", Status: "open"},
+		models.Task{ID: "lazybeads-2", Status: "open"},
+	)
+	m.setPanelFocus(FocusOpen)
+	m.openPanel.SelectTaskByID("lazybeads-2")
+
+	// Closed elsewhere, e.g. by an agent, between two polls
+	updated, _ := m.Update(tasksLoadedMsg{tasks: []models.Task{
+		{ID: "lazybeads-1", Status: "open"},
+		{ID: "lazybeads-2", Status: "closed"},
+	}})
+	m = updated.(Model)
+	if m.focusedPanel != FocusClosed || m.selected == nil || m.selected.ID != "lazybeads-2" {
+		t.Errorf("expected focus to follow lazybeads-2 to the closed panel, got %v %+v", m.focusedPanel, m.selected)
+	}
+}
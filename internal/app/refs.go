@@ -0,0 +1,281 @@
+package app
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+// issueRef is an issue ID found in the detail view
+type issueRef struct {
+	id   string
+	line int // line of the detail content the ID is on
+}
+
+// issueRefPattern builds a regexp matching IDs with any of the prefixes used
+// by the loaded issues, e.g. "lazybeads-42" or "lazybeads-a1b2.3"
+func issueRefPattern(tasks []models.Task) *regexp.Regexp {
+	seen := make(map[string]bool)
+	var prefixes []string
+	for _, task := range tasks {
+		dash := strings.LastIndex(task.ID, "-")
+		if dash <= 0 {
+			continue
+		}
+		prefix := task.ID[:dash]
+		if strings.Contains(prefix, ".") {
+			continue
+		}
+		if !seen[prefix] {
+			seen[prefix] = true
+			prefixes = append(prefixes, regexp.QuoteMeta(prefix))
+		}
+	}
+	if len(prefixes) == 0 {
+		return nil
+	}
+	// Longest first so "my-app" wins over "my"
+	sort.Slice(prefixes, func(i, j int) bool { return len(prefixes[i]) > len(prefixes[j]) })
+	return regexp.MustCompile(`(?:` + strings.Join(prefixes, "|") + `)-[A-Za-z0-9]+(?:\.[0-9]+)*`)
+}
+
+// findIssueRefs returns the byte ranges in plain text of IDs that known
+// accepts. Matches inside longer words, paths or URLs are ignored.
+func findIssueRefs(plain string, pattern *regexp.Regexp, known func(id string) bool) [][2]int {
+	if pattern == nil {
+		return nil
+	}
+	var refs [][2]int
+	for _, loc := range pattern.FindAllStringIndex(plain, -1) {
+		start, end := loc[0], loc[1]
+		if start > 0 && (isWordByte(plain[start-1]) || strings.IndexByte("-./", plain[start-1]) >= 0) {
+			continue
+		}
+		if end < len(plain) && (isWordByte(plain[end]) || plain[end] == '-') {
+			continue
+		}
+		if known(plain[start:end]) {
+			refs = append(refs, [2]int{start, end})
+		}
+	}
+	return refs
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// stripANSI returns line without escape sequences and, for every byte of the
+// result, its offset in line
+func stripANSI(line string) (string, []int) {
+	var plain strings.Builder
+	offsets := make([]int, 0, len(line))
+	for i := 0; i < len(line); {
+		if line[i] == '\x1b' {
+			i = skipEscape(line, i)
+			continue
+		}
+		plain.WriteByte(line[i])
+		offsets = append(offsets, i)
+		i++
+	}
+	return plain.String(), offsets
+}
+
+// skipEscape returns the offset just past the escape sequence starting at i
+func skipEscape(s string, i int) int {
+	i++
+	if i >= len(s) {
+		return i
+	}
+	switch s[i] {
+	case '[':
+		// CSI: ends with a byte in the range @ to ~
+		for i++; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1
+			}
+		}
+		return i
+	case ']':
+		// OSC: ends with BEL or ESC \
+		for i++; i < len(s); i++ {
+			if s[i] == '\a' {
+				return i + 1
+			}
+			if s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+		return i
+	}
+	return i + 1
+}
+
+// linkIssueRefs styles every known issue ID in the rendered detail content
+// and returns the IDs in reading order. The ref at index selected is
+// highlighted.
+func linkIssueRefs(content string, pattern *regexp.Regexp, known func(id string) bool, selected int) (string, []issueRef) {
+	if pattern == nil {
+		return content, nil
+	}
+	lines := strings.Split(content, "\n")
+	var refs []issueRef
+	for n, line := range lines {
+		plain, offsets := stripANSI(line)
+		matches := findIssueRefs(plain, pattern, known)
+		if len(matches) == 0 {
+			continue
+		}
+
+		var b strings.Builder
+		last := 0
+		for _, match := range matches {
+			start := offsets[match[0]]
+			end := offsets[match[1]-1] + 1
+			text := plain[match[0]:match[1]]
+			style := ui.IssueLinkStyle
+			if len(refs) == selected {
+				style = ui.SelectedIssueLinkStyle
+				if ui.NoColor() {
+					text = "[" + text + "]"
+				}
+			}
+			b.WriteString(line[last:start])
+			b.WriteString(style.Render(text))
+			last = end
+			refs = append(refs, issueRef{id: plain[match[0]:match[1]], line: n})
+		}
+		b.WriteString(line[last:])
+		lines[n] = b.String()
+	}
+	return strings.Join(lines, "\n"), refs
+}
+
+// selectDetailLink moves the link cursor by delta, wrapping around, and
+// scrolls the link into view
+func (m *Model) selectDetailLink(delta int) {
+	m.updateDetailContent()
+	if len(m.detailRefs) == 0 {
+		m.detailLink = -1
+		return
+	}
+	if m.detailLink < 0 {
+		if delta > 0 {
+			m.detailLink = 0
+		} else {
+			m.detailLink = len(m.detailRefs) - 1
+		}
+	} else {
+		m.detailLink = (m.detailLink + delta + len(m.detailRefs)) % len(m.detailRefs)
+	}
+	m.updateDetailContent()
+
+	line := m.detailRefs[m.detailLink].line
+	if line < m.detail.YOffset {
+		m.detail.SetYOffset(line)
+	} else if line >= m.detail.YOffset+m.detail.Height {
+		m.detail.SetYOffset(line - m.detail.Height + 1)
+	}
+}
+
+// followDetailLink jumps to the issue under the link cursor
+func (m *Model) followDetailLink() tea.Cmd {
+	if m.detailLink < 0 || m.detailLink >= len(m.detailRefs) || m.selected == nil {
+		return nil
+	}
+	from := m.selected.ID
+	target := m.detailRefs[m.detailLink].id
+	if !m.revealTask(target) {
+		return m.flashStatus(target + " is not loaded")
+	}
+	m.navBack = append(m.navBack, from)
+	m.navForward = nil
+	return nil
+}
+
+// navigateHistory moves back (-1) or forward (+1) through followed links
+func (m *Model) navigateHistory(direction int) tea.Cmd {
+	from, to := &m.navBack, &m.navForward
+	if direction > 0 {
+		from, to = &m.navForward, &m.navBack
+	}
+	for len(*from) > 0 {
+		id := (*from)[len(*from)-1]
+		*from = (*from)[:len(*from)-1]
+		current := ""
+		if m.selected != nil {
+			current = m.selected.ID
+		}
+		if m.revealTask(id) {
+			if current != "" {
+				*to = append(*to, current)
+			}
+			return nil
+		}
+	}
+	if direction > 0 {
+		return m.flashStatus("No newer issue in history")
+	}
+	return m.flashStatus("No older issue in history")
+}
+
+// revealTask selects the issue in its panel, clearing the filter if it hides
+// the issue, and shows it in the detail view
+func (m *Model) revealTask(id string) bool {
+	task, ok := m.taskByID(id)
+	if !ok {
+		return false
+	}
+	if !m.selectTaskInPanels(task) {
+		if m.filterQuery == "" {
+			return false
+		}
+		m.filterQuery = ""
+		m.searchInput.SetValue("")
+		m.distributeTasks()
+		if !m.selectTaskInPanels(task) {
+			return false
+		}
+	}
+	m.selected = m.getSelectedTask()
+	m.detailLink = -1
+	m.updateDetailContent()
+	m.detail.GotoTop()
+	return true
+}
+
+// selectTaskInPanels selects task in the panel for its status, falling back
+// to any panel that lists it (e.g. nested under an epic)
+func (m *Model) selectTaskInPanels(task models.Task) bool {
+	order := []PanelFocus{FocusOpen, FocusInProgress, FocusClosed}
+	switch task.Status {
+	case "in_progress":
+		order = []PanelFocus{FocusInProgress, FocusOpen, FocusClosed}
+	case "closed":
+		order = []PanelFocus{FocusClosed, FocusOpen, FocusInProgress}
+	}
+	for _, focus := range order {
+		if m.panel(focus).SelectTaskByID(task.ID) {
+			m.setPanelFocus(focus)
+			return true
+		}
+	}
+	return false
+}
+
+// panel returns the panel for a focus value
+func (m *Model) panel(focus PanelFocus) *PanelModel {
+	switch focus {
+	case FocusInProgress:
+		return &m.inProgressPanel
+	case FocusClosed:
+		return &m.closedPanel
+	}
+	return &m.openPanel
+}
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"testing"
+
+	"lazybeads/internal/models"
+)
+
+func TestFindIssueRefs(t *testing.T) {
+	tasks := []models.Task{{ID: "lazybeads-1"}, {ID: "lazybeads-a1b2"}, {ID: "lazybeads-a1b2.1"}, {ID: "my-app-7"}}
+	pattern := issueRefPattern(tasks)
+	known := func(id string) bool {
+		for _, task := range tasks {
+			if task.ID == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	plain := "See lazybeads-1, lazybeads-a1b2.1 and my-app-7. Not lazybeads-99, xlazybeads-1, docs/lazybeads-1 or lazybeads-1-old."
+	var got []string
+	for _, r := range findIssueRefs(plain, pattern, known) {
+		got = append(got, plain[r[0]:r[1]])
+	}
+
+	expected := []string{"lazybeads-1", "lazybeads-a1b2.1", "my-app-7"}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("ref %d: expected %s, got %s", i, expected[i], got[i])
+		}
+	}
+}
+
+func TestLinkIssueRefsSkipsEscapeSequences(t *testing.T) {
+	tasks := []models.Task{{ID: "lazybeads-1"}, {ID: "lazybeads-2"}}
+	pattern := issueRefPattern(tasks)
+	known := func(id string) bool { return true }
+
+	content := "Parent:\x1b[1mlazybeads-1\x1b[0m\n  - lazybeads-2 title"
+	out, refs := linkIssueRefs(content, pattern, known, -1)
+
+	if len(refs) != 2 {
+		t.Fatalf("expected 2 refs, got %+v", refs)
+	}
+	if refs[0].id != "lazybeads-1" || refs[0].line != 0 {
+		t.Errorf("unexpected first ref: %+v", refs[0])
+	}
+	if refs[1].id != "lazybeads-2" || refs[1].line != 1 {
+		t.Errorf("unexpected second ref: %+v", refs[1])
+	}
+	if plain, _ := stripANSI(out); plain != "Parent:lazybeads-1\n  - lazybeads-2 title" {
+		t.Errorf("linking changed the text: %q", plain)
+	}
+}
+
+func TestFollowLinkAndHistory(t *testing.T) {
+	m := newTestModel(t,
+		models.Task{ID: "lazybeads-1", Title: "Start", Status: "open", Description: "Depends on lazybeads-2"},
+		models.Task{ID: "lazybeads-2", Title: "Target", Status: "closed"},
+	)
+
+	m.filterQuery = "Start"
+	m.distributeTasks()
+	m.selected = m.getSelectedTask()
+	m.mode = ViewDetail
+	m.detailTab = detailTabText
+
+	m.selectDetailLink(1)
+	if m.detailLink != 0 || len(m.detailRefs) != 1 {
+		t.Fatalf("expected one selected link, got %d of %+v", m.detailLink, m.detailRefs)
+	}
+	m.followDetailLink()
+
+	if m.selected == nil || m.selected.ID != "lazybeads-2" {
+		t.Fatalf("expected lazybeads-2 to be selected, got %+v", m.selected)
+	}
+	if m.focusedPanel != FocusClosed {
+		t.Errorf("expected closed panel to be focused, got %v", m.focusedPanel)
+	}
+	if m.filterQuery != "" {
+		t.Errorf("expected filter hiding the target to be cleared, got %q", m.filterQuery)
+	}
+
+	m.navigateHistory(-1)
+	if m.selected == nil || m.selected.ID != "lazybeads-1" {
+		t.Fatalf("expected back to lazybeads-1, got %+v", m.selected)
+	}
+	m.navigateHistory(1)
+	if m.selected == nil || m.selected.ID != "lazybeads-2" {
+		t.Fatalf("expected forward to lazybeads-2, got %+v", m.selected)
+	}
+}
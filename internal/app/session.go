@@ -0,0 +1,91 @@
+package app
+
+import (
+	"sort"
+
+	"lazybeads/internal/session"
+)
+
+// panelNames name the panels in the saved session
+var panelNames = map[PanelFocus]string{
+	FocusInProgress: "in_progress",
+	FocusOpen:       "open",
+	FocusClosed:     "closed",
+}
+
+// sessionState captures where the user is, to pick up there next launch
+func (m *Model) sessionState() session.State {
+	state := session.State{
+		FocusedPanel:  panelNames[m.focusedPanel],
+		PanelAdjust:   m.panelAdjust,
+		Filter:        m.filterQuery,
+		PaletteRecent: m.paletteRecent,
+	}
+	if task := m.getSelectedTask(); task != nil {
+		state.SelectedID = task.ID
+	}
+	for id, expanded := range m.expandedEpics {
+		if expanded {
+			state.ExpandedEpics = append(state.ExpandedEpics, id)
+		}
+	}
+	sort.Strings(state.ExpandedEpics)
+	return state
+}
+
+// saveSession keeps the session state on quit. It is best effort: there is
+// no screen left to report a failure on.
+func (m *Model) saveSession() {
+	if m.sessionStore != nil {
+		_ = m.sessionStore.Save(m.sessionState())
+	}
+}
+
+// restoreSession applies the saved layout, filter and recent palette
+// entries straight away; focus and selection wait for the first task list
+func (m *Model) restoreSession(state session.State) {
+	m.panelAdjust = state.PanelAdjust
+	m.filterQuery = state.Filter
+	m.searchInput.SetValue(state.Filter)
+	m.paletteRecent = state.PaletteRecent
+	if len(state.ExpandedEpics) > 0 {
+		m.expandedEpics = make(map[string]bool, len(state.ExpandedEpics))
+		for _, id := range state.ExpandedEpics {
+			m.expandedEpics[id] = true
+		}
+	}
+	m.pendingSession = &state
+}
+
+// restoreSessionSelection selects the saved issue in the saved panel, or
+// in the panel for its status if it has moved since. When the issue is
+// gone only the panel is focused.
+func (m *Model) restoreSessionSelection() {
+	state := m.pendingSession
+	m.pendingSession = nil
+
+	focus, known := FocusOpen, false
+	for f, name := range panelNames {
+		if name == state.FocusedPanel {
+			focus, known = f, true
+		}
+	}
+	if focus == FocusInProgress && !m.isInProgressVisible() {
+		known = false
+	}
+
+	if task, ok := m.taskByID(state.SelectedID); ok {
+		if known && m.panel(focus).SelectTaskByID(task.ID) {
+			m.setPanelFocus(focus)
+			m.selected = m.getSelectedTask()
+			return
+		}
+		if m.selectTaskInPanels(task) {
+			m.selected = m.getSelectedTask()
+			return
+		}
+	}
+	if known {
+		m.setPanelFocus(focus)
+	}
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/models"
+)
+
+func TestSessionRestoredOnNextLaunch(t *testing.T) {
+	useTempDirs(t)
+	tasks := []models.Task{
+		{ID: "lazybeads-1", Title: "Doing", Status: "in_progress"},
+		{ID: "lazybeads-2", Title: "Fix auth", Status: "open"},
+		{ID: "lazybeads-3", Title: "Auth docs", Status: "closed"},
+	}
+	launch := func() Model {
+		return launchTestModel(tasks...)
+	}
+
+	m := launch()
+	m.filterQuery = "auth"
+	m.distributeTasks()
+	m.setPanelFocus(FocusClosed)
+	m.panelAdjust = -10
+	m.updateSizes()
+	m.paletteRecent = []string{"action:refresh", "issue:lazybeads-2"}
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlC}); cmd == nil {
+		t.Fatal("expected ctrl+c to quit")
+	}
+
+	m = launch()
+	if m.focusedPanel != FocusClosed || m.selected == nil || m.selected.ID != "lazybeads-3" {
+		t.Errorf("expected lazybeads-3 selected in the closed panel, got %v %+v", m.focusedPanel, m.selected)
+	}
+	if m.filterQuery != "auth" || m.inProgressPanel.TaskCount() != 0 {
+		t.Errorf("expected the auth filter restored, got %q", m.filterQuery)
+	}
+	if m.panelAdjust != -10 {
+		t.Errorf("expected panel adjustment -10, got %d", m.panelAdjust)
+	}
+	if len(m.paletteRecent) != 2 || m.paletteRecent[0] != "action:refresh" {
+		t.Errorf("expected the recent palette entries restored, got %v", m.paletteRecent)
+	}
+}
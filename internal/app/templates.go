@@ -0,0 +1,135 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/config"
+	"lazybeads/internal/ui"
+)
+
+const blankTemplateValue = "blank"
+
+// templateData is the data available to issue template text
+type templateData struct {
+	Date   string
+	Parent string
+}
+
+// showFormOrTemplates opens the create form, asking for a template first when
+// any are configured. Form state must already be reset by the caller.
+func (m *Model) showFormOrTemplates() {
+	if len(m.templates) == 0 {
+		m.markFormOpened()
+		m.mode = ViewForm
+		m.formTitle.Focus()
+		return
+	}
+
+	options := []ui.ModalOption{{Label: "Blank", Value: blankTemplateValue, Shortcut: "0"}}
+	for i, tpl := range m.templates {
+		opt := ui.ModalOption{Label: tpl.Name, Value: strconv.Itoa(i)}
+		if i < 9 {
+			opt.Shortcut = strconv.Itoa(i + 1)
+		}
+		options = append(options, opt)
+	}
+
+	subtitle := ""
+	if m.formLink.targetID != "" {
+		subtitle = m.formLink.formTitle()
+	}
+	m.modal = ui.NewModalSelect("Choose Template", subtitle, options, "")
+	m.mode = ViewTemplate
+}
+
+func (m *Model) handleTemplateKeys(msg tea.KeyMsg) tea.Cmd {
+	keyStr := msg.String()
+	if !m.modal.SelectByShortcut(keyStr) {
+		switch keyStr {
+		case "k", "up":
+			m.modal.MoveUp()
+			return nil
+		case "j", "down":
+			m.modal.MoveDown()
+			return nil
+		case "enter":
+		default:
+			return nil
+		}
+	}
+
+	if idx, err := strconv.Atoi(m.modal.SelectedValue()); err == nil && idx >= 0 && idx < len(m.templates) {
+		m.applyTemplate(m.templates[idx])
+	}
+	m.markFormOpened()
+	m.mode = ViewForm
+	m.formFocus = 0
+	m.updateFormFocus()
+	return nil
+}
+
+// applyTemplate fills the form from a template. Labels are merged with any
+// inherited from a linked issue.
+func (m *Model) applyTemplate(tpl config.IssueTemplate) {
+	data := templateData{
+		Date:   time.Now().Format("2006-01-02"),
+		Parent: m.formLink.targetID,
+	}
+
+	var renderErr error
+	render := func(text string) string {
+		out, err := renderTemplateText(text, data)
+		if err != nil {
+			if renderErr == nil {
+				renderErr = fmt.Errorf("template %q: %w", tpl.Name, err)
+			}
+			return text
+		}
+		return out
+	}
+
+	if tpl.Type != "" {
+		m.formType = tpl.Type
+	}
+	if tpl.Priority != nil && *tpl.Priority >= 0 && *tpl.Priority <= 4 {
+		m.formPriority = *tpl.Priority
+	}
+	labels := splitLabels(m.formLabels.Value())
+	for _, label := range tpl.Labels {
+		if !containsString(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+	m.formLabels.SetValue(strings.Join(labels, ", "))
+	m.setFormText(&m.formDesc, "Description", render(tpl.Description))
+	m.setFormText(&m.formNotes, "Notes", render(tpl.Notes))
+	m.setFormText(&m.formDesign, "Design", render(tpl.Design))
+	m.setFormText(&m.formAcceptance, "Acceptance criteria", render(tpl.AcceptanceCriteria))
+	m.updateFormTextAreaHeights()
+
+	if renderErr != nil {
+		m.err = renderErr
+	}
+}
+
+func renderTemplateText(text string, data templateData) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+	tmpl, err := template.New("issue").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
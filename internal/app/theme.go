@@ -0,0 +1,116 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"lazybeads/internal/config"
+	"lazybeads/internal/ui"
+)
+
+// themeAuto picks the dark or light theme from the terminal background
+const themeAuto = "auto"
+
+// ResolveTheme returns the configured theme. On errors such as an unknown
+// name or a bad color it still returns a usable theme alongside the error.
+func ResolveTheme(cfg config.ThemeConfig) (ui.Theme, error) {
+	return resolveTheme(cfg, lipgloss.HasDarkBackground)
+}
+
+func resolveTheme(cfg config.ThemeConfig, darkBackground func() bool) (ui.Theme, error) {
+	auto := func() ui.Theme {
+		if darkBackground() {
+			return ui.DarkTheme()
+		}
+		return ui.LightTheme()
+	}
+
+	name := cfg.Name
+	if name == "" || name == themeAuto {
+		return auto(), nil
+	}
+	if theme, ok := ui.BuiltinTheme(name); ok {
+		return theme, nil
+	}
+
+	palette, ok := cfg.Palettes[name]
+	if !ok {
+		available := append(ui.BuiltinThemeNames(), themeAuto)
+		for palette := range cfg.Palettes {
+			available = append(available, palette)
+		}
+		sort.Strings(available)
+		return auto(), fmt.Errorf("unknown theme %q (available: %s)", name, strings.Join(available, ", "))
+	}
+
+	var theme ui.Theme
+	switch palette.Base {
+	case "", ui.ThemeDark:
+		theme = ui.DarkTheme()
+	case themeAuto:
+		theme = auto()
+	default:
+		if theme, ok = ui.BuiltinTheme(palette.Base); !ok {
+			return auto(), fmt.Errorf("theme %q: unknown base theme %q", name, palette.Base)
+		}
+	}
+	theme.Name = name
+
+	var problems []string
+	color := func(field string, value string, target *lipgloss.Color) {
+		if value == "" {
+			return
+		}
+		c, err := ui.ParseColor(value)
+		if err != nil {
+			problems = append(problems, field+": "+err.Error())
+			return
+		}
+		*target = c
+	}
+
+	color("primary", palette.Primary, &theme.Primary)
+	color("secondary", palette.Secondary, &theme.Secondary)
+	color("accent", palette.Accent, &theme.Accent)
+	color("warning", palette.Warning, &theme.Warning)
+	color("danger", palette.Danger, &theme.Danger)
+	color("muted", palette.Muted, &theme.Muted)
+	color("text", palette.Text, &theme.Text)
+	color("border", palette.Border, &theme.Border)
+	color("selection", palette.Selection, &theme.Selection)
+	color("selectionText", palette.SelectionText, &theme.SelectionText)
+
+	theme.Status = make(map[string]lipgloss.Color)
+	for status, value := range palette.Status {
+		var c lipgloss.Color
+		color("status."+status, value, &c)
+		if c != "" {
+			theme.Status[status] = c
+		}
+	}
+	theme.Priority = make(map[int]lipgloss.Color)
+	for priority, value := range palette.Priority {
+		var c lipgloss.Color
+		color(fmt.Sprintf("priority.%d", priority), value, &c)
+		if c != "" {
+			theme.Priority[priority] = c
+		}
+	}
+	theme.Type = make(map[string]lipgloss.Color)
+	for issueType, value := range palette.Type {
+		var c lipgloss.Color
+		color("type."+issueType, value, &c)
+		if c != "" {
+			theme.Type[issueType] = c
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return theme, fmt.Errorf("theme %q: %s", name, strings.Join(problems, "; "))
+	}
+	return theme, nil
+}
@@ -0,0 +1,79 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"lazybeads/internal/config"
+	"lazybeads/internal/ui"
+)
+
+func TestResolveThemeAuto(t *testing.T) {
+	dark := func() bool { return true }
+	light := func() bool { return false }
+
+	if theme, err := resolveTheme(config.ThemeConfig{}, dark); err != nil || theme.Name != ui.ThemeDark {
+		t.Errorf("expected dark theme on a dark background, got %q (%v)", theme.Name, err)
+	}
+	if theme, err := resolveTheme(config.ThemeConfig{Name: "auto"}, light); err != nil || theme.Name != ui.ThemeLight {
+		t.Errorf("expected light theme on a light background, got %q (%v)", theme.Name, err)
+	}
+	if theme, err := resolveTheme(config.ThemeConfig{Name: "high-contrast"}, light); err != nil || theme.Name != ui.ThemeHighContrast {
+		t.Errorf("expected the named built-in theme, got %q (%v)", theme.Name, err)
+	}
+}
+
+func TestResolveThemePalette(t *testing.T) {
+	cfg := config.ThemeConfig{
+		Name: "solarized",
+		Palettes: map[string]config.Palette{
+			"solarized": {
+				Base:     "light",
+				Primary:  "#859900",
+				Status:   map[string]string{"in_progress": "#b58900"},
+				Priority: map[int]string{0: "160"},
+				Type:     map[string]string{"bug": "#dc322f"},
+			},
+		},
+	}
+
+	theme, err := resolveTheme(cfg, func() bool { return true })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if theme.Name != "solarized" || theme.Primary != lipgloss.Color("#859900") {
+		t.Errorf("expected palette colors, got %+v", theme)
+	}
+	if theme.Text != ui.LightTheme().Text {
+		t.Errorf("expected unset colors from the light base, got text %q", theme.Text)
+	}
+	if theme.Status["in_progress"] != "#b58900" || theme.Priority[0] != "160" || theme.Type["bug"] != "#dc322f" {
+		t.Errorf("expected status, priority and type overrides, got %v %v %v", theme.Status, theme.Priority, theme.Type)
+	}
+}
+
+func TestResolveThemeErrors(t *testing.T) {
+	dark := func() bool { return true }
+
+	theme, err := resolveTheme(config.ThemeConfig{Name: "neon"}, dark)
+	if err == nil || !strings.Contains(err.Error(), `unknown theme "neon"`) {
+		t.Errorf("expected unknown theme error, got %v", err)
+	}
+	if theme.Name != ui.ThemeDark {
+		t.Errorf("expected fallback to the auto theme, got %q", theme.Name)
+	}
+
+	cfg := config.ThemeConfig{
+		Name:     "mine",
+		Palettes: map[string]config.Palette{"mine": {Primary: "green", Danger: "#f00"}},
+	}
+	theme, err = resolveTheme(cfg, dark)
+	if err == nil || !strings.Contains(err.Error(), `primary: invalid color "green"`) {
+		t.Errorf("expected invalid color error, got %v", err)
+	}
+	if theme.Danger != "#f00" || theme.Primary != ui.DarkTheme().Primary {
+		t.Errorf("expected valid colors applied and invalid ones left at the base, got %+v", theme)
+	}
+}
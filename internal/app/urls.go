@@ -0,0 +1,339 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"lazybeads/internal/models"
+	"lazybeads/internal/ui"
+)
+
+var (
+	// urlPattern matches http(s) URLs; trailing punctuation is trimmed after
+	urlPattern = regexp.MustCompile(`https?://[^\s<>"'\x60]+`)
+
+	// fileRefPattern matches path/to/file.ext:line with an optional :column
+	fileRefPattern = regexp.MustCompile(`[\w.~/-]*\w\.[A-Za-z][A-Za-z0-9]*:(\d+)(?::\d+)?`)
+)
+
+// textLink is a URL or file reference found in issue text
+type textLink struct {
+	url  string
+	path string
+	line int
+}
+
+// String returns the link as written in the issue
+func (l textLink) String() string {
+	if l.url != "" {
+		return l.url
+	}
+	return fmt.Sprintf("%s:%d", l.path, l.line)
+}
+
+// linkSpan is where a link appears in a piece of text
+type linkSpan struct {
+	start, end int
+	link       textLink
+}
+
+// scanLinks finds URLs and file:line references in text. File references
+// are not checked against the filesystem.
+func scanLinks(text string) []linkSpan {
+	var spans []linkSpan
+	for _, loc := range urlPattern.FindAllStringIndex(text, -1) {
+		url := trimURL(text[loc[0]:loc[1]])
+		spans = append(spans, linkSpan{start: loc[0], end: loc[0] + len(url), link: textLink{url: url}})
+	}
+
+	for _, loc := range fileRefPattern.FindAllStringSubmatchIndex(text, -1) {
+		start, end := loc[0], loc[1]
+		if start > 0 && !strings.ContainsRune(" \t([{\"'`", rune(text[start-1])) {
+			continue
+		}
+		if end < len(text) && (isWordByte(text[end]) || text[end] == ':') {
+			continue
+		}
+		if insideSpan(spans, start) {
+			continue
+		}
+		line, err := strconv.Atoi(text[loc[2]:loc[3]])
+		if err != nil || line == 0 {
+			continue
+		}
+		path := text[start : loc[2]-1]
+		spans = append(spans, linkSpan{start: start, end: end, link: textLink{path: path, line: line}})
+	}
+	return spans
+}
+
+// trimURL drops punctuation that ends a sentence or closes markup around a URL
+func trimURL(url string) string {
+	for len(url) > 0 {
+		last := url[len(url)-1]
+		switch {
+		case strings.IndexByte(".,;:!?*_", last) >= 0:
+			url = url[:len(url)-1]
+		case last == ')' && strings.Count(url, "(") < strings.Count(url, ")"):
+			url = url[:len(url)-1]
+		case last == ']' && strings.Count(url, "[") < strings.Count(url, "]"):
+			url = url[:len(url)-1]
+		default:
+			return url
+		}
+	}
+	return url
+}
+
+func insideSpan(spans []linkSpan, pos int) bool {
+	for _, span := range spans {
+		if pos >= span.start && pos < span.end {
+			return true
+		}
+	}
+	return false
+}
+
+// taskLinks returns every distinct URL and file reference in the issue's
+// text fields. File references are kept only if exists reports the file.
+func taskLinks(task models.Task, exists func(path string) bool) []textLink {
+	var links []textLink
+	seen := make(map[string]bool)
+	for _, text := range []string{task.Description, task.Notes, task.Design, task.AcceptanceCriteria, task.CloseReason} {
+		for _, span := range scanLinks(text) {
+			key := span.link.String()
+			if seen[key] || span.link.path != "" && !exists(span.link.path) {
+				continue
+			}
+			seen[key] = true
+			links = append(links, span.link)
+		}
+	}
+	return links
+}
+
+// taskLinkCache keeps the links of the last issue looked up, so file
+// references are not checked on every render. It is shared by the copies
+// of the model and renewed when another issue or a newer version of it is
+// shown.
+type taskLinkCache struct {
+	valid     bool
+	id        string
+	updatedAt time.Time
+	links     []textLink
+}
+
+// cachedTaskLinks returns the links of an issue, from the cache if it holds
+// this version of the issue
+func (m Model) cachedTaskLinks(task models.Task) []textLink {
+	c := m.linkCache
+	if c == nil {
+		return taskLinks(task, linkFileExists)
+	}
+	if !c.valid || c.id != task.ID || !c.updatedAt.Equal(task.UpdatedAt) {
+		*c = taskLinkCache{valid: true, id: task.ID, updatedAt: task.UpdatedAt, links: taskLinks(task, linkFileExists)}
+	}
+	return c.links
+}
+
+// resolveLinkPath expands ~ and makes a referenced path absolute
+func resolveLinkPath(path string) string {
+	if strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, path[2:])
+		}
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return path
+}
+
+func linkFileExists(path string) bool {
+	info, err := os.Stat(resolveLinkPath(path))
+	return err == nil && !info.IsDir()
+}
+
+// hyperlinksSupported guesses whether the terminal understands OSC 8
+// hyperlinks. Terminals that don't may print the escape codes.
+func hyperlinksSupported() bool {
+	term := os.Getenv("TERM")
+	if term == "dumb" || term == "linux" {
+		return false
+	}
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "ghostty", "Hyper", "Tabby", "rio":
+		return true
+	}
+	if os.Getenv("KITTY_WINDOW_ID") != "" || os.Getenv("WT_SESSION") != "" || os.Getenv("KONSOLE_VERSION") != "" {
+		return true
+	}
+	if vte, err := strconv.Atoi(os.Getenv("VTE_VERSION")); err == nil && vte >= 5000 {
+		return true
+	}
+	for _, name := range []string{"kitty", "foot", "alacritty", "wezterm", "ghostty"} {
+		if strings.Contains(term, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// hyperlinkURI returns the target of an OSC 8 hyperlink for a link
+func hyperlinkURI(link textLink) string {
+	if link.url != "" {
+		return link.url
+	}
+	return "file://" + filepath.ToSlash(resolveLinkPath(link.path))
+}
+
+// hyperlinkContent wraps each of links shown in the rendered content in an
+// OSC 8 hyperlink. Only links found intact on one line are wrapped, so a URL
+// split by word wrapping is left alone.
+func hyperlinkContent(content string, links []textLink) string {
+	if len(links) == 0 {
+		return content
+	}
+	targets := make(map[string]string, len(links))
+	for _, link := range links {
+		targets[link.String()] = hyperlinkURI(link)
+	}
+
+	lines := strings.Split(content, "\n")
+	for n, line := range lines {
+		plain, offsets := stripANSI(line)
+		spans := scanLinks(plain)
+		if len(spans) == 0 {
+			continue
+		}
+
+		var b strings.Builder
+		last := 0
+		for _, span := range spans {
+			uri, ok := targets[span.link.String()]
+			if !ok {
+				continue
+			}
+			start := offsets[span.start]
+			end := offsets[span.end-1] + 1
+			if start < last {
+				continue
+			}
+			b.WriteString(line[last:start])
+			b.WriteString("\x1b]8;;" + uri + "\x1b\\")
+			b.WriteString(line[start:end])
+			b.WriteString("\x1b]8;;\x1b\\")
+			last = end
+		}
+		b.WriteString(line[last:])
+		lines[n] = b.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// linkOpenedMsg is sent when a link has been opened or failed to open
+type linkOpenedMsg struct {
+	err error
+}
+
+// openLinkPicker lists the links in the selected issue
+func (m *Model) openLinkPicker() tea.Cmd {
+	task := m.selected
+	if m.mode == ViewList || task == nil {
+		task = m.getSelectedTask()
+	}
+	if task == nil {
+		return nil
+	}
+	links := m.cachedTaskLinks(*task)
+	if len(links) == 0 {
+		return m.flashStatus("No links in " + task.ID)
+	}
+
+	options := make([]ui.ModalOption, len(links))
+	for i, link := range links {
+		options[i] = ui.ModalOption{Label: link.String(), Value: strconv.Itoa(i)}
+		if i < 9 {
+			options[i].Shortcut = strconv.Itoa(i + 1)
+		}
+	}
+	m.modal = ui.NewModalSelect("Open Link", task.ID, options, "")
+	m.pickerLinks = links
+	m.linkPickerReturn = m.mode
+	m.mode = ViewLinkPicker
+	return nil
+}
+
+func (m *Model) handleLinkPickerKeys(msg tea.KeyMsg) tea.Cmd {
+	keyStr := msg.String()
+	if !m.modal.SelectByShortcut(keyStr) {
+		switch keyStr {
+		case "k", "up":
+			m.modal.MoveUp()
+			return nil
+		case "j", "down":
+			m.modal.MoveDown()
+			return nil
+		case "esc":
+			m.mode = m.linkPickerReturn
+			return nil
+		case "enter":
+		default:
+			return nil
+		}
+	}
+
+	m.mode = m.linkPickerReturn
+	i, err := strconv.Atoi(m.modal.SelectedValue())
+	if err != nil || i < 0 || i >= len(m.pickerLinks) {
+		return nil
+	}
+	return m.openTextLink(m.pickerLinks[i])
+}
+
+// openTextLink opens a URL with the configured opener, or a file in $EDITOR
+// at the referenced line
+func (m *Model) openTextLink(link textLink) tea.Cmd {
+	if link.url != "" {
+		opener := strings.Fields(m.linkOpener)
+		if len(opener) == 0 {
+			opener = defaultLinkOpener()
+		}
+		c := exec.Command(opener[0], append(opener[1:], link.url)...)
+		if err := c.Start(); err != nil {
+			m.err = fmt.Errorf("failed to open %s: %w", link.url, err)
+			return nil
+		}
+		go c.Wait()
+		return m.flashStatus("Opened " + link.url)
+	}
+
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "nano"
+	}
+	c := exec.Command(editor, fmt.Sprintf("+%d", link.line), resolveLinkPath(link.path))
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		return linkOpenedMsg{err: err}
+	})
+}
+
+// defaultLinkOpener returns the platform command for opening URLs
+func defaultLinkOpener() []string {
+	switch runtime.GOOS {
+	case "darwin":
+		return []string{"open"}
+	case "windows":
+		return []string{"rundll32", "url.dll,FileProtocolHandler"}
+	}
+	return []string{"xdg-open"}
+}
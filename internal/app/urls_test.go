@@ -0,0 +1,96 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"lazybeads/internal/models"
+)
+
+func TestScanLinks(t *testing.T) {
+	text := "Fixed in https://github.com/org/repo/pull/12. See internal/app/views.go:42 and (main.go:7:3), " +
+		"docs at <https://example.com/a_(b)>, not example.com:8080 or v1.2:3x or https://host/x.go:5"
+
+	var got []string
+	for _, span := range scanLinks(text) {
+		got = append(got, span.link.String())
+		if text[span.start:span.end] != span.link.String() && span.link.url != "" {
+			t.Errorf("span %q does not match link %q", text[span.start:span.end], span.link.String())
+		}
+	}
+
+	expected := []string{
+		"https://github.com/org/repo/pull/12",
+		"https://example.com/a_(b)",
+		"https://host/x.go:5",
+		"internal/app/views.go:42",
+		"main.go:7",
+		"example.com:8080",
+	}
+	if strings.Join(got, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestTaskLinksDropsMissingFiles(t *testing.T) {
+	task := models.Task{
+		Description: "PR: https://github.com/org/repo/pull/12\nBroken at views.go:10",
+		Notes:       "Same PR https://github.com/org/repo/pull/12 and example.com:8080",
+	}
+	exists := func(path string) bool { return path == "views.go" }
+
+	links := taskLinks(task, exists)
+	if len(links) != 2 {
+		t.Fatalf("expected 2 links, got %+v", links)
+	}
+	if links[0].url != "https://github.com/org/repo/pull/12" {
+		t.Errorf("expected PR url first, got %+v", links[0])
+	}
+	if links[1].path != "views.go" || links[1].line != 10 {
+		t.Errorf("expected views.go:10, got %+v", links[1])
+	}
+}
+
+func TestTaskLinksCachedPerIssueVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.go")
+	if err := os.WriteFile(path, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	task := models.Task{ID: "lazybeads-1", Description: "See " + path + ":3", UpdatedAt: time.Now()}
+	m := Model{linkCache: &taskLinkCache{}}
+
+	if links := m.cachedTaskLinks(task); len(links) != 1 {
+		t.Fatalf("expected the file link, got %+v", links)
+	}
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if links := m.cachedTaskLinks(task); len(links) != 1 {
+		t.Errorf("expected the same version of the issue not to check the file again, got %+v", links)
+	}
+
+	task.UpdatedAt = task.UpdatedAt.Add(time.Second)
+	if links := m.cachedTaskLinks(task); len(links) != 0 {
+		t.Errorf("expected a new version of the issue to check the file again, got %+v", links)
+	}
+}
+
+func TestHyperlinkContent(t *testing.T) {
+	links := []textLink{{url: "https://example.com/pr/1"}}
+	content := "Notes:\n\x1b[1mSee https://example.com/pr/1 now\x1b[0m\nhttps://example.com/pr/"
+
+	out := hyperlinkContent(content, links)
+	lines := strings.Split(out, "\n")
+	if !strings.Contains(lines[1], "\x1b]8;;https://example.com/pr/1\x1b\\https://example.com/pr/1\x1b]8;;\x1b\\") {
+		t.Errorf("expected OSC 8 hyperlink, got %q", lines[1])
+	}
+	if lines[2] != "https://example.com/pr/" {
+		t.Errorf("partial URL should not be linked, got %q", lines[2])
+	}
+	if plain, _ := stripANSI(out); plain != "Notes:\nSee https://example.com/pr/1 now\nhttps://example.com/pr/" {
+		t.Errorf("hyperlinks changed the text: %q", plain)
+	}
+}
@@ -3,8 +3,9 @@ package app
 import (
 	"fmt"
 	"strings"
-	"time"
 
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/lipgloss"
 
 	"lazybeads/internal/ui"
@@ -19,21 +20,38 @@ func (m Model) View() string {
 	switch m.mode {
 	case ViewHelp:
 		return m.viewHelp()
+	case ViewPalette:
+		return m.viewPalette()
 	case ViewConfirm:
 		return m.viewConfirm()
+	case ViewAnalysis:
+		return m.viewAnalysis()
+	case ViewChanges:
+		return m.viewChanges()
+	case ViewConflict:
+		return m.viewConflict()
 	case ViewForm:
 		return m.viewForm()
-	case ViewDetail:
+	case ViewDetail, ViewChecklist:
 		if m.width < 80 {
 			// Narrow mode: full screen detail
 			return m.viewDetailOverlay()
 		}
 		return m.viewMain()
-	case ViewEditTitle, ViewEditStatus, ViewEditPriority, ViewEditType, ViewFilter:
+	}
+	if isModalMode(m.mode) {
 		return m.viewMainWithModal()
-	default:
-		return m.viewMain()
 	}
+	return m.viewMain()
+}
+
+// isModalMode reports whether a mode is drawn as a centered modal
+func isModalMode(mode ViewMode) bool {
+	switch mode {
+	case ViewEditTitle, ViewEditStatus, ViewEditPriority, ViewEditType, ViewFilter, ViewCycles, ViewLinkKind, ViewTemplate, ViewCapture, ViewRestoreDraft, ViewLinkPicker, ViewGoto:
+		return true
+	}
+	return false
 }
 
 func (m Model) viewMain() string {
@@ -54,14 +72,14 @@ func (m Model) viewMain() string {
 	if m.width >= wideModeMinWidth {
 		// Wide mode: panels on left, detail on right
 		detailStyle := ui.PanelStyle
-		if m.mode == ViewDetail {
+		if m.mode == ViewDetail || m.mode == ViewChecklist {
 			detailStyle = ui.FocusedPanelStyle
 		}
 
 		detailContent := ""
 		if m.selected != nil {
 			m.updateDetailContent()
-			detailContent = m.detail.View()
+			detailContent = m.renderDetailTabs(m.detail.Width) + "\n\n" + m.detail.View()
 		} else {
 			detailContent = ui.HelpDescStyle.Render("Select a task to view details")
 		}
@@ -103,10 +121,14 @@ func (m Model) viewDetailOverlay() string {
 	content := ui.OverlayStyle.
 		Width(m.width - 4).
 		Height(m.height - 6).
-		Render(m.detail.View())
+		Render(m.renderDetailTabs(m.detail.Width) + "\n\n" + m.detail.View())
 	b.WriteString(content)
 	b.WriteString("\n")
-	b.WriteString(ui.HelpBarStyle.Render("enter/esc: back  ?: help"))
+	if m.mode == ViewChecklist {
+		b.WriteString(ui.HelpBarStyle.Render("j/k: move  space/x: tick  esc: back"))
+	} else {
+		b.WriteString(ui.HelpBarStyle.Render("[/]: tabs  {/}: links  enter: follow/back  ^o/^i: history  esc: back  ?: help"))
+	}
 
 	return b.String()
 }
@@ -184,6 +206,21 @@ func (m Model) renderStatusBar() string {
 		parts = append(parts, ui.SuccessStyle.Render(m.statusMsg))
 	}
 
+	// Dependency cycles never resolve on their own, so keep them visible
+	if len(m.cycles) > 0 {
+		noun := "cycles"
+		if len(m.cycles) == 1 {
+			noun = "cycle"
+		}
+		badge := ui.WarningStyle.Render(fmt.Sprintf("%s %d dependency %s", ui.Glyphs.Warning, len(m.cycles), noun))
+		parts = append(parts, badge+" "+ui.HelpKeyStyle.Render("!")+":"+ui.HelpDescStyle.Render("show"))
+	}
+
+	// Changes made elsewhere stay counted until the log is opened
+	if badge := m.changesBadge(); badge != "" {
+		parts = append(parts, badge)
+	}
+
 	// When in search mode, show the search input
 	if m.searchMode {
 		// Search input with cursor
@@ -212,6 +249,10 @@ func (m Model) renderStatusBar() string {
 		// Minimal key hints during search
 		parts = append(parts, ui.HelpKeyStyle.Render("enter")+":"+ui.HelpDescStyle.Render("confirm"))
 		parts = append(parts, ui.HelpKeyStyle.Render("esc")+":"+ui.HelpDescStyle.Render("clear"))
+	} else if m.mode == ViewChecklist {
+		parts = append(parts, ui.HelpKeyStyle.Render("j/k")+":"+ui.HelpDescStyle.Render("move"))
+		parts = append(parts, ui.HelpKeyStyle.Render("space/x")+":"+ui.HelpDescStyle.Render("tick"))
+		parts = append(parts, ui.HelpKeyStyle.Render("esc")+":"+ui.HelpDescStyle.Render("back"))
 	} else if m.filterQuery != "" {
 		// When filter is active (but not in search mode), show search results
 		// Filter indicator
@@ -247,7 +288,7 @@ func (m Model) renderStatusBar() string {
 			desc string
 		}{
 			{"j/k", "nav"},
-			{"h/l, ←/→, tab/shift+tab", "panel"},
+			{"h/l, " + ui.Glyphs.LeftRight + ", tab/shift+tab", "panel"},
 			{"/", "filter"},
 			{"enter", "detail"},
 			{"e/s/p/t/d/N/D/C", "edit"},
@@ -273,120 +314,47 @@ func (m *Model) updateDetailContent() {
 	}
 
 	t := m.selected
-	var b strings.Builder
-
-	b.WriteString(ui.DetailLabelStyle.Render("ID:"))
-	b.WriteString(ui.DetailValueStyle.Render(t.ID))
-	b.WriteString("\n")
-
-	b.WriteString(ui.DetailLabelStyle.Render("Title:"))
-	b.WriteString(ui.DetailValueStyle.Render(t.Title))
-	b.WriteString("\n")
-
-	b.WriteString(ui.DetailLabelStyle.Render("Status:"))
-	b.WriteString(ui.StatusStyle(t.Status).Render(t.Status))
-	b.WriteString("\n")
-
-	b.WriteString(ui.DetailLabelStyle.Render("Priority:"))
-	b.WriteString(ui.PriorityStyle(t.Priority).Render(t.PriorityString()))
-	b.WriteString("\n")
-
-	b.WriteString(ui.DetailLabelStyle.Render("Type:"))
-	b.WriteString(ui.DetailValueStyle.Render(t.Type))
-	b.WriteString("\n")
-
-	if t.Assignee != "" {
-		b.WriteString(ui.DetailLabelStyle.Render("Assignee:"))
-		b.WriteString(ui.DetailValueStyle.Render(t.Assignee))
-		b.WriteString("\n")
+	if t.ID != m.detailLinkID {
+		m.detailLinkID = t.ID
+		m.detailLink = -1
+		m.resetDetailScroll()
 	}
 
-	if len(t.Labels) > 0 {
-		b.WriteString(ui.DetailLabelStyle.Render("Labels:"))
-		b.WriteString(ui.DetailValueStyle.Render(strings.Join(t.Labels, ", ")))
-		b.WriteString("\n")
-	}
-
-	if t.DueDate != nil {
-		b.WriteString(ui.DetailLabelStyle.Render("Due:"))
-		b.WriteString(ui.DetailValueStyle.Render(t.DueDate.Format("2006-01-02")))
-		b.WriteString("\n")
-	}
-
-	if t.IsDeferred(time.Now()) {
-		b.WriteString(ui.DetailLabelStyle.Render("Deferred:"))
-		b.WriteString(ui.DetailValueStyle.Render("until " + t.DeferUntil.Format("2006-01-02")))
-		b.WriteString("\n")
-	}
-
-	renderWrappedSection := func(label, value string) {
-		if value == "" {
-			return
-		}
-		b.WriteString("\n")
-		b.WriteString(ui.DetailLabelStyle.Render(label))
-		b.WriteString("\n")
-		descWidth := m.detail.Width - 2
-		if descWidth < 20 {
-			descWidth = 20
+	var b strings.Builder
+	if m.mode == ViewChecklist {
+		if items := taskChecklist(*t); len(items) > 0 {
+			b.WriteString(m.renderChecklistSection(items, m.detail.Width))
+			b.WriteString("\n")
 		}
-		wrapped := lipgloss.NewStyle().Width(descWidth).Render(value)
-		b.WriteString(wrapped)
-		b.WriteString("\n")
 	}
 
-	renderWrappedSection("Description:", t.Description)
-	renderWrappedSection("Notes:", t.Notes)
-	renderWrappedSection("Design:", t.Design)
-	renderWrappedSection("Acceptance Criteria:", t.AcceptanceCriteria)
-	renderWrappedSection("Close Reason:", t.CloseReason)
-
-	if len(t.BlockedBy) > 0 {
-		taskTitles := make(map[string]string, len(m.tasks))
-		for _, task := range m.tasks {
-			taskTitles[task.ID] = task.Title
-		}
-		b.WriteString("\n")
-		b.WriteString(ui.DetailLabelStyle.Render("Blocked by:"))
-		b.WriteString("\n")
-		for _, id := range t.BlockedBy {
-			line := id
-			if title, ok := taskTitles[id]; ok && title != "" {
-				line = fmt.Sprintf("%s %s", id, title)
-			}
-			b.WriteString("  - " + line + "\n")
-		}
+	switch m.detailTab {
+	case detailTabText:
+		m.renderDetailText(&b, t)
+	case detailTabDependencies:
+		m.renderDetailDependencies(&b, t)
+	case detailTabHistory:
+		m.renderDetailHistory(&b, t)
+	case detailTabComments:
+		m.renderDetailComments(&b, t)
+	default:
+		m.renderDetailOverview(&b, t)
 	}
 
-	if len(t.Blocks) > 0 {
-		b.WriteString("\n")
-		b.WriteString(ui.DetailLabelStyle.Render("Blocks:"))
-		b.WriteString("\n")
-		for _, id := range t.Blocks {
-			b.WriteString("  - " + id + "\n")
-		}
+	known := func(id string) bool {
+		_, ok := m.taskByID(id)
+		return ok && id != t.ID
 	}
-
-	// Timestamps section
-	b.WriteString("\n")
-	b.WriteString(ui.DetailLabelStyle.Render("Created:"))
-	b.WriteString(ui.DetailValueStyle.Render(t.CreatedAt.Format("2006-01-02 15:04")))
-	if t.CreatedBy != "" {
-		b.WriteString(ui.HelpDescStyle.Render(" by " + t.CreatedBy))
+	content := b.String()
+	if m.hyperlinks {
+		content = hyperlinkContent(content, m.cachedTaskLinks(*t))
 	}
-	b.WriteString("\n")
-
-	b.WriteString(ui.DetailLabelStyle.Render("Updated:"))
-	b.WriteString(ui.DetailValueStyle.Render(t.UpdatedAt.Format("2006-01-02 15:04")))
-	b.WriteString("\n")
-
-	if t.ClosedAt != nil {
-		b.WriteString(ui.DetailLabelStyle.Render("Closed:"))
-		b.WriteString(ui.DetailValueStyle.Render(t.ClosedAt.Format("2006-01-02 15:04")))
-		b.WriteString("\n")
+	content, refs := linkIssueRefs(content, m.issueRefRe, known, m.detailLink)
+	m.detailRefs = refs
+	if m.detailLink >= len(refs) {
+		m.detailLink = -1
 	}
-
-	m.detail.SetContent(b.String())
+	m.detail.SetContent(content)
 }
 
 func (m Model) viewForm() string {
@@ -408,7 +376,9 @@ func (m Model) formViewBlocks() ([]string, string, string) {
 
 	title := "New Task"
 	if m.editing {
-		title = "Edit Task"
+		title = "Edit " + m.editingID
+	} else if m.formLink.targetID != "" {
+		title = m.formLink.formTitle()
 	}
 	blocks = append(blocks, ui.TitleStyle.Render(title)+"\n\n")
 
@@ -422,19 +392,19 @@ func (m Model) formViewBlocks() ([]string, string, string) {
 	blocks = append(blocks, titleLabel+"\n"+titleInput+"\n\n")
 
 	// Description field
-	descLabel := ui.FormLabelStyle.Render("Description:")
+	descLabel := m.formTextLabel("Description:", m.formDesc)
 	blocks = append(blocks, descLabel+"\n"+m.formDesc.View()+"\n\n")
 
 	// Notes field
-	notesLabel := ui.FormLabelStyle.Render("Notes:")
+	notesLabel := m.formTextLabel("Notes:", m.formNotes)
 	blocks = append(blocks, notesLabel+"\n"+m.formNotes.View()+"\n\n")
 
 	// Design field
-	designLabel := ui.FormLabelStyle.Render("Design:")
+	designLabel := m.formTextLabel("Design:", m.formDesign)
 	blocks = append(blocks, designLabel+"\n"+m.formDesign.View()+"\n\n")
 
 	// Acceptance field
-	acceptLabel := ui.FormLabelStyle.Render("Acceptance Criteria:")
+	acceptLabel := m.formTextLabel("Acceptance Criteria:", m.formAcceptance)
 	blocks = append(blocks, acceptLabel+"\n"+m.formAcceptance.View()+"\n\n")
 
 	// Priority selector
@@ -470,18 +440,51 @@ func (m Model) formViewBlocks() ([]string, string, string) {
 	}
 	blocks = append(blocks, typeLabel+typeValue+focusIndicator+"\n\n")
 
+	blocks = append(blocks, m.formInputBlock("Labels:", m.formLabels, 7))
+	blocks = append(blocks, m.formInputBlock("Assignee:", m.formAssignee, 8))
+	blocks = append(blocks, m.formInputBlock("Due:", m.formDue, 9))
+	blocks = append(blocks, m.formInputBlock("Defer until:", m.formDefer, 10))
+
 	// Submit button
 	buttonStyle := ui.FormButtonStyle
-	if m.formFocus == 7 {
+	if m.formFocus == formSubmitFocus {
 		buttonStyle = ui.FormButtonFocusedStyle
 	}
 	buttonText := "Submit"
 	button := buttonStyle.Render(buttonText)
 
 	help := ui.HelpBarStyle.Render("tab/shift+tab: next/prev field  ^e: edit in $EDITOR  alt+enter: focus submit  enter: newline/activate button  esc: cancel")
+	if m.formWarning != "" {
+		help = ui.WarningStyle.Render(ui.Glyphs.Warning+" "+m.formWarning) + "\n" + help
+	}
 	return blocks, button, help
 }
 
+// formTextLabel renders a text field label with live line and character
+// counts, and marks fields whose content is taller than the visible area.
+func (m Model) formTextLabel(label string, ta textarea.Model) string {
+	chars := fmt.Sprintf("%d chars", ta.Length())
+	if ta.CharLimit > 0 {
+		chars = fmt.Sprintf("%d/%d chars", ta.Length(), ta.CharLimit)
+	}
+	out := ui.FormLabelStyle.Render(label) + ui.HelpDescStyle.Render(fmt.Sprintf("%d lines %s %s", ta.LineCount(), ui.Glyphs.Dot, chars))
+
+	contentHeight := calcTextareaHeight(ta.Value(), max(ta.Width(), 1))
+	if contentHeight > ta.Height() {
+		out += "  " + ui.WarningStyle.Render(fmt.Sprintf("%s %d of %d lines shown", ui.Glyphs.Scroll, ta.Height(), contentHeight))
+	}
+	return out
+}
+
+// formInputBlock renders a labelled single-line form field
+func (m Model) formInputBlock(label string, input textinput.Model, focus int) string {
+	style := ui.FormInputStyle
+	if m.formFocus == focus {
+		style = ui.FormInputFocusedStyle
+	}
+	return ui.FormLabelStyle.Render(label) + "\n" + style.Width(m.width-20).Render(input.View()) + "\n\n"
+}
+
 func max(a, b int) int {
 	if a > b {
 		return a
@@ -5,13 +5,23 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"lazybeads/internal/models"
 )
 
+// dateFormat is the date layout bd accepts for --due and --defer
+const dateFormat = "2006-01-02"
+
 // Client wraps the bd CLI commands
-type Client struct{}
+type Client struct {
+	dirOnce sync.Once
+	dir     string // the .beads directory, see beadsDir
+}
 
 // NewClient creates a new beads client
 func NewClient() *Client {
@@ -56,6 +66,11 @@ func (c *Client) ListOpen() ([]models.Task, error) {
 	return c.List("--status=open")
 }
 
+// Children returns all tasks that have parentID as their parent
+func (c *Client) Children(parentID string) ([]models.Task, error) {
+	return c.List("--parent", parentID, "--all")
+}
+
 // Ready returns tasks with no blockers
 func (c *Client) Ready() ([]models.Task, error) {
 	args := []string{"ready", "--json"}
@@ -108,6 +123,177 @@ func (c *Client) Show(id string) (*models.Task, error) {
 	return &tasks[0], nil
 }
 
+// Comments returns the comments on a task, oldest first
+func (c *Client) Comments(id string) ([]models.Comment, error) {
+	out, err := exec.Command("bd", "comments", id, "--json").Output()
+	if err != nil {
+		return nil, fmt.Errorf("bd comments failed: %w", err)
+	}
+
+	var comments []models.Comment
+	if err := json.Unmarshal(out, &comments); err != nil {
+		return nil, fmt.Errorf("failed to parse bd comments output: %w", err)
+	}
+
+	return comments, nil
+}
+
+// interactionsLog is the append-only audit log bd keeps next to the issues
+const interactionsLog = "interactions.jsonl"
+
+// beadsDir returns the .beads directory of the project bd works on. It is
+// where bd keeps its database, which may be in a parent of the working
+// directory.
+func (c *Client) beadsDir() string {
+	c.dirOnce.Do(func() {
+		c.dir = c.databaseDir()
+		if c.dir == "" {
+			cwd, _ := os.Getwd()
+			c.dir = findBeadsDir(cwd)
+		}
+	})
+	return c.dir
+}
+
+// databaseDir asks bd where its database is, returning "" if it can't tell
+func (c *Client) databaseDir() string {
+	out, err := exec.Command("bd", "info", "--json").Output()
+	if err != nil {
+		return ""
+	}
+	var info struct {
+		DatabasePath string `json:"database_path"`
+	}
+	if err := json.Unmarshal(out, &info); err != nil || info.DatabasePath == "" {
+		return ""
+	}
+	return filepath.Dir(info.DatabasePath)
+}
+
+// ProjectDir returns the repository bd works in from the working directory:
+// the one holding the nearest .beads directory, or the working directory
+// itself when there is none
+func ProjectDir() (string, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("failed to get the working directory: %w", err)
+	}
+	return filepath.Dir(findBeadsDir(cwd)), nil
+}
+
+// findBeadsDir looks for a .beads directory in dir and its parents, like bd
+// does, falling back to the one in dir
+func findBeadsDir(dir string) string {
+	for d := dir; ; {
+		candidate := filepath.Join(d, ".beads")
+		if info, err := os.Stat(candidate); err == nil && info.IsDir() {
+			return candidate
+		}
+		parent := filepath.Dir(d)
+		if parent == d {
+			return filepath.Join(dir, ".beads")
+		}
+		d = parent
+	}
+}
+
+// History returns the audit trail of a task, oldest first. It uses the
+// events bd includes with the issue and falls back to the interactions log
+// when there are none.
+func (c *Client) History(id string) ([]models.Event, error) {
+	out, err := exec.Command("bd", "show", id, "--json").Output()
+	if err != nil {
+		return nil, fmt.Errorf("bd show failed: %w", err)
+	}
+
+	var shown []struct {
+		Events []models.Event `json:"events"`
+	}
+	if err := json.Unmarshal(out, &shown); err != nil {
+		return nil, fmt.Errorf("failed to parse bd show output: %w", err)
+	}
+
+	var events []models.Event
+	if len(shown) > 0 {
+		events = shown[0].Events
+	}
+	if len(events) == 0 {
+		events, err = readInteractions(filepath.Join(c.beadsDir(), interactionsLog), id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].CreatedAt.Before(events[j].CreatedAt) })
+	return events, nil
+}
+
+// interaction is one line of the interactions log
+type interaction struct {
+	ID        string         `json:"id"`
+	Kind      string         `json:"kind"`
+	CreatedAt time.Time      `json:"created_at"`
+	Actor     string         `json:"actor"`
+	IssueID   string         `json:"issue_id"`
+	Label     string         `json:"label"`
+	Reason    string         `json:"reason"`
+	ToolName  string         `json:"tool_name"`
+	Extra     map[string]any `json:"extra"`
+}
+
+// readInteractions returns the entries of the interactions log for an issue
+// as events. A missing log means no history.
+func readInteractions(path, id string) ([]models.Event, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var events []models.Event
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		var entry interaction
+		if err := json.Unmarshal([]byte(line), &entry); err != nil || entry.IssueID != id {
+			// Skip entries from other issues and lines from newer formats
+			continue
+		}
+		events = append(events, entry.event())
+	}
+	return events, nil
+}
+
+// event converts an interaction into an audit event. Field changes recorded
+// in extra as field/old/new become an "updated" event.
+func (e interaction) event() models.Event {
+	event := models.Event{
+		IssueID:   e.IssueID,
+		Type:      e.Kind,
+		Actor:     e.Actor,
+		CreatedAt: e.CreatedAt,
+	}
+
+	comment := strings.TrimSpace(strings.Join([]string{e.ToolName, e.Label, e.Reason}, " "))
+	if comment != "" {
+		event.Comment = &comment
+	}
+
+	if field, ok := e.Extra["field"].(string); ok && field != "" {
+		old, _ := json.Marshal(map[string]any{field: e.Extra["old"]})
+		updated, _ := json.Marshal(map[string]any{field: e.Extra["new"]})
+		oldValue, newValue := string(old), string(updated)
+		event.Type = "updated"
+		event.OldValue = &oldValue
+		event.NewValue = &newValue
+	}
+	return event
+}
+
 // CreateOptions holds options for creating a task
 type CreateOptions struct {
 	Title              string
@@ -118,6 +304,9 @@ type CreateOptions struct {
 	Type               string // task, bug, feature, epic, chore
 	Priority           int    // 0-4
 	Labels             []string
+	Assignee           string
+	Due                *time.Time
+	Defer              *time.Time
 }
 
 // Create creates a new task
@@ -145,6 +334,15 @@ func (c *Client) Create(opts CreateOptions) (*models.Task, error) {
 	if len(opts.Labels) > 0 {
 		args = append(args, "-l", strings.Join(opts.Labels, ","))
 	}
+	if opts.Assignee != "" {
+		args = append(args, "--assignee", opts.Assignee)
+	}
+	if opts.Due != nil {
+		args = append(args, "--due", opts.Due.Format(dateFormat))
+	}
+	if opts.Defer != nil {
+		args = append(args, "--defer", opts.Defer.Format(dateFormat))
+	}
 
 	out, err := exec.Command("bd", args...).Output()
 	if err != nil {
@@ -171,6 +369,14 @@ type UpdateOptions struct {
 	Notes              string
 	Design             string
 	AcceptanceCriteria string
+	AddLabels          []string
+	RemoveLabels       []string
+	Due                *time.Time
+	Defer              *time.Time
+
+	// Clear lists fields to reset to empty, by flag name: description,
+	// notes, design, acceptance, assignee, due, defer
+	Clear []string
 }
 
 // Update modifies an existing task
@@ -204,6 +410,21 @@ func (c *Client) Update(id string, opts UpdateOptions) error {
 	if opts.AcceptanceCriteria != "" {
 		args = append(args, "--acceptance", opts.AcceptanceCriteria)
 	}
+	for _, label := range opts.AddLabels {
+		args = append(args, "--add-label", label)
+	}
+	for _, label := range opts.RemoveLabels {
+		args = append(args, "--remove-label", label)
+	}
+	if opts.Due != nil {
+		args = append(args, "--due", opts.Due.Format(dateFormat))
+	}
+	if opts.Defer != nil {
+		args = append(args, "--defer", opts.Defer.Format(dateFormat))
+	}
+	for _, field := range opts.Clear {
+		args = append(args, "--"+field, "")
+	}
 
 	cmd := exec.Command("bd", args...)
 	if err := cmd.Run(); err != nil {
@@ -228,6 +449,32 @@ func (c *Client) Close(id string, reason string) error {
 	return nil
 }
 
+// AddDependency makes issueID depend on dependsOnID with the given
+// dependency type (blocks, parent-child, ...)
+func (c *Client) AddDependency(issueID, dependsOnID, depType string) error {
+	args := []string{"dep", "add", issueID, dependsOnID}
+	if depType != "" {
+		args = append(args, "--type", depType)
+	}
+
+	cmd := exec.Command("bd", args...)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("bd dep add failed: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveDependency removes the dependency of issueID on dependsOnID
+func (c *Client) RemoveDependency(issueID, dependsOnID string) error {
+	cmd := exec.Command("bd", "dep", "remove", issueID, dependsOnID)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("bd dep remove failed: %w", err)
+	}
+
+	return nil
+}
+
 // Delete removes a task
 func (c *Client) Delete(id string) error {
 	cmd := exec.Command("bd", "delete", id, "--force")
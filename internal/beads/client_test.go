@@ -2,6 +2,7 @@ package beads
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -103,6 +104,82 @@ func TestClient_Show(t *testing.T) {
 	t.Logf("Showed task: %s - %s", task.ID, task.Title)
 }
 
+func TestClient_Comments(t *testing.T) {
+	skipIfNoBeads(t)
+	client := NewClient()
+
+	tasks, err := client.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	if len(tasks) == 0 {
+		t.Skip("No tasks to read comments from")
+	}
+
+	comments, err := client.Comments(tasks[0].ID)
+	if err != nil {
+		t.Fatalf("Comments failed: %v", err)
+	}
+
+	t.Logf("Found %d comments on %s", len(comments), tasks[0].ID)
+}
+
+func TestReadInteractions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "interactions.jsonl")
+	log := `{"id":"int-1","kind":"field_change","created_at":"2026-10-01T09:00:00Z","actor":"alice","issue_id":"bd-1","extra":{"field":"status","old":"open","new":"closed"}}
+{"id":"int-2","kind":"llm_call","created_at":"2026-10-01T10:00:00Z","actor":"agent","issue_id":"bd-2"}
+not json
+{"id":"int-3","kind":"label","created_at":"2026-10-01T11:00:00Z","actor":"bob","issue_id":"bd-1","label":"good"}
+`
+	if err := os.WriteFile(path, []byte(log), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := readInteractions(path, "bd-1")
+	if err != nil {
+		t.Fatalf("readInteractions failed: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %+v", events)
+	}
+
+	if events[0].Type != "updated" || events[0].Actor != "alice" || *events[0].NewValue != `{"status":"closed"}` {
+		t.Errorf("expected status change as update, got %+v", events[0])
+	}
+	if events[1].Type != "label" || events[1].Comment == nil || *events[1].Comment != "good" {
+		t.Errorf("expected label entry with comment, got %+v", events[1])
+	}
+
+	if events, err := readInteractions(filepath.Join(t.TempDir(), "missing.jsonl"), "bd-1"); err != nil || events != nil {
+		t.Errorf("expected no events for a missing log, got %+v, %v", events, err)
+	}
+}
+
+func TestFindBeadsDir(t *testing.T) {
+	root := t.TempDir()
+	sub := filepath.Join(root, "src", "pkg")
+	if err := os.MkdirAll(filepath.Join(root, ".beads"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(sub, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if dir := findBeadsDir(sub); dir != filepath.Join(root, ".beads") {
+		t.Errorf("expected the .beads directory of a parent, got %s", dir)
+	}
+	other := t.TempDir()
+	if dir := findBeadsDir(other); dir != filepath.Join(other, ".beads") {
+		t.Errorf("expected the working directory without a .beads directory, got %s", dir)
+	}
+
+	t.Chdir(sub)
+	if dir, err := ProjectDir(); err != nil || dir != root {
+		t.Errorf("expected the repository root %s, got %s (err %v)", root, dir, err)
+	}
+}
+
 func TestClient_CreateAndDelete(t *testing.T) {
 	skipIfNoBeads(t)
 	client := NewClient()
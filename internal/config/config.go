@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 
@@ -9,7 +10,99 @@ import (
 
 // Config represents the application configuration
 type Config struct {
-	CustomCommands []CustomCommand `yaml:"customCommands"`
+	CustomCommands []CustomCommand   `yaml:"customCommands"`
+	Templates      []IssueTemplate   `yaml:"templates"`
+	Form           FormConfig        `yaml:"form"`
+	Links          LinksConfig       `yaml:"links"`
+	Keybindings    KeybindingsConfig `yaml:"keybindings"`
+	Theme          ThemeConfig       `yaml:"theme"`
+	Display        DisplayConfig     `yaml:"display"`
+	Views          []SavedView       `yaml:"views"`
+}
+
+// SavedView is a named filter that the command palette can apply
+type SavedView struct {
+	Name   string `yaml:"name"`
+	Filter string `yaml:"filter"` // matched against issue titles and IDs, like /
+}
+
+// DisplayConfig adapts rendering to limited terminals and screen readers.
+// NO_COLOR and TERM=dumb turn these on as well.
+type DisplayConfig struct {
+	ASCII   bool `yaml:"ascii"`   // ASCII-only markers, borders and tree prefixes
+	NoColor bool `yaml:"noColor"` // no colors; selection and state shown as text
+}
+
+// ThemeConfig selects a color theme: a built-in one (dark, light,
+// high-contrast), one of the named palettes, or auto to pick dark or light
+// from the terminal background.
+type ThemeConfig struct {
+	Name     string             `yaml:"name"`
+	Palettes map[string]Palette `yaml:"palettes"`
+}
+
+// Palette overrides colors of a base theme. Colors are ANSI numbers (0-255)
+// or hex values such as "#2a4a6d".
+type Palette struct {
+	Base          string `yaml:"base"` // built-in theme to start from; defaults to dark
+	Primary       string `yaml:"primary"`
+	Secondary     string `yaml:"secondary"`
+	Accent        string `yaml:"accent"`
+	Warning       string `yaml:"warning"`
+	Danger        string `yaml:"danger"`
+	Muted         string `yaml:"muted"`
+	Text          string `yaml:"text"`
+	Border        string `yaml:"border"`
+	Selection     string `yaml:"selection"`
+	SelectionText string `yaml:"selectionText"`
+
+	Status   map[string]string `yaml:"status"`   // open, in_progress, closed
+	Priority map[int]string    `yaml:"priority"` // 0-4
+	Type     map[string]string `yaml:"type"`     // task, bug, feature, epic, chore
+}
+
+// KeybindingsConfig remaps built-in actions by name, e.g. editStatus: S.
+// Global overrides apply everywhere; list and detail overrides apply on top
+// of them in the issue list and the detail view.
+type KeybindingsConfig struct {
+	Global map[string]KeyList `yaml:"global"`
+	List   map[string]KeyList `yaml:"list"`
+	Detail map[string]KeyList `yaml:"detail"`
+}
+
+// KeyList is one key or a list of keys. An empty list unbinds the action.
+type KeyList []string
+
+// UnmarshalYAML accepts a single key as well as a sequence of keys
+func (k *KeyList) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		var key string
+		if err := node.Decode(&key); err != nil {
+			return err
+		}
+		*k = KeyList{key}
+		return nil
+	}
+	var keys []string
+	if err := node.Decode(&keys); err != nil {
+		return err
+	}
+	*k = KeyList(keys)
+	return nil
+}
+
+// FormConfig tunes the create/edit form. Zero values mean no character limit
+// and the default field height.
+type FormConfig struct {
+	TitleLimit  int `yaml:"titleLimit"`  // max title characters
+	TextLimit   int `yaml:"textLimit"`   // max characters per text field
+	FieldHeight int `yaml:"fieldHeight"` // visible lines per text field before it scrolls
+}
+
+// LinksConfig controls how URLs and file references in issues are opened
+type LinksConfig struct {
+	Opener     string `yaml:"opener"`     // command URLs are passed to; defaults to open or xdg-open
+	Hyperlinks *bool  `yaml:"hyperlinks"` // emit OSC 8 hyperlinks; unset detects terminal support
 }
 
 // CustomCommand represents a user-defined command
@@ -20,6 +113,19 @@ type CustomCommand struct {
 	Command     string `yaml:"command"`
 }
 
+// IssueTemplate prefills the create form. Text fields are Go templates with
+// {{.Date}} and {{.Parent}} available.
+type IssueTemplate struct {
+	Name               string   `yaml:"name"`
+	Type               string   `yaml:"type"`     // task, bug, feature, epic, chore
+	Priority           *int     `yaml:"priority"` // 0-4, unset keeps the form default
+	Labels             []string `yaml:"labels"`
+	Description        string   `yaml:"description"`
+	Notes              string   `yaml:"notes"`
+	Design             string   `yaml:"design"`
+	AcceptanceCriteria string   `yaml:"acceptance"`
+}
+
 // Load reads the configuration from the default location
 func Load() (*Config, error) {
 	configPath := ConfigPath()
@@ -46,6 +152,16 @@ func Load() (*Config, error) {
 		}
 	}
 
+	// Name unnamed templates after their type
+	for i := range cfg.Templates {
+		if cfg.Templates[i].Name == "" {
+			cfg.Templates[i].Name = cfg.Templates[i].Type
+		}
+		if cfg.Templates[i].Name == "" {
+			cfg.Templates[i].Name = fmt.Sprintf("Template %d", i+1)
+		}
+	}
+
 	return &cfg, nil
 }
 
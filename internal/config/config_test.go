@@ -180,3 +180,189 @@ func TestDefaultContext(t *testing.T) {
 		t.Errorf("expected default context to be 'list', got '%s'", cfg.CustomCommands[0].Context)
 	}
 }
+
+func TestLoadTemplates(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, "lazybeads"), 0755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	configContent := `templates:
+  - name: "Bug report"
+    type: "bug"
+    priority: 1
+    labels: ["triage"]
+    description: |
+      Reported {{.Date}}
+    acceptance: "- [ ] reproduced"
+  - type: "chore"
+  - description: "no name or type"
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "lazybeads", "config.yml"), []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write test config: %v", err)
+	}
+
+	originalUserConfigDir := os.Getenv("XDG_CONFIG_HOME")
+	os.Setenv("XDG_CONFIG_HOME", tmpDir)
+	defer os.Setenv("XDG_CONFIG_HOME", originalUserConfigDir)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	if len(cfg.Templates) != 3 {
+		t.Fatalf("expected 3 templates, got %d", len(cfg.Templates))
+	}
+
+	bug := cfg.Templates[0]
+	if bug.Name != "Bug report" || bug.Type != "bug" {
+		t.Errorf("unexpected first template: %+v", bug)
+	}
+	if bug.Priority == nil || *bug.Priority != 1 {
+		t.Errorf("expected priority 1, got %v", bug.Priority)
+	}
+	if len(bug.Labels) != 1 || bug.Labels[0] != "triage" {
+		t.Errorf("expected labels [triage], got %v", bug.Labels)
+	}
+	if bug.AcceptanceCriteria != "- [ ] reproduced" {
+		t.Errorf("expected acceptance criteria to be loaded, got '%s'", bug.AcceptanceCriteria)
+	}
+
+	if cfg.Templates[1].Name != "chore" {
+		t.Errorf("expected unnamed template to take its type as name, got '%s'", cfg.Templates[1].Name)
+	}
+	if cfg.Templates[1].Priority != nil {
+		t.Errorf("expected unset priority to stay nil, got %d", *cfg.Templates[1].Priority)
+	}
+	if cfg.Templates[2].Name != "Template 3" {
+		t.Errorf("expected fallback template name, got '%s'", cfg.Templates[2].Name)
+	}
+}
+
+func TestLoadFormConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, "lazybeads"), 0755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	configContent := `form:
+  textLimit: 5000
+  fieldHeight: 6
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "lazybeads", "config.yml"), []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write test config: %v", err)
+	}
+
+	originalUserConfigDir := os.Getenv("XDG_CONFIG_HOME")
+	os.Setenv("XDG_CONFIG_HOME", tmpDir)
+	defer os.Setenv("XDG_CONFIG_HOME", originalUserConfigDir)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	if cfg.Form.TitleLimit != 0 {
+		t.Errorf("expected unset title limit to be 0 (unlimited), got %d", cfg.Form.TitleLimit)
+	}
+	if cfg.Form.TextLimit != 5000 {
+		t.Errorf("expected text limit 5000, got %d", cfg.Form.TextLimit)
+	}
+	if cfg.Form.FieldHeight != 6 {
+		t.Errorf("expected field height 6, got %d", cfg.Form.FieldHeight)
+	}
+}
+
+func TestLoadLinksConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, "lazybeads"), 0755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	configContent := `links:
+  opener: firefox --new-tab
+  hyperlinks: false
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "lazybeads", "config.yml"), []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write test config: %v", err)
+	}
+
+	originalUserConfigDir := os.Getenv("XDG_CONFIG_HOME")
+	os.Setenv("XDG_CONFIG_HOME", tmpDir)
+	defer os.Setenv("XDG_CONFIG_HOME", originalUserConfigDir)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	if cfg.Links.Opener != "firefox --new-tab" {
+		t.Errorf("expected opener 'firefox --new-tab', got '%s'", cfg.Links.Opener)
+	}
+	if cfg.Links.Hyperlinks == nil || *cfg.Links.Hyperlinks {
+		t.Errorf("expected hyperlinks to be explicitly disabled, got %v", cfg.Links.Hyperlinks)
+	}
+}
+
+func TestLoadKeybindings(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, "lazybeads"), 0755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	configContent := `keybindings:
+  global:
+    quit: [q, ctrl+q]
+  list:
+    editStatus: S
+  detail:
+    toggleMarkdown: []
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "lazybeads", "config.yml"), []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write test config: %v", err)
+	}
+
+	originalUserConfigDir := os.Getenv("XDG_CONFIG_HOME")
+	os.Setenv("XDG_CONFIG_HOME", tmpDir)
+	defer os.Setenv("XDG_CONFIG_HOME", originalUserConfigDir)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	if quit := cfg.Keybindings.Global["quit"]; len(quit) != 2 || quit[1] != "ctrl+q" {
+		t.Errorf("expected quit keys [q ctrl+q], got %v", quit)
+	}
+	if status := cfg.Keybindings.List["editStatus"]; len(status) != 1 || status[0] != "S" {
+		t.Errorf("expected a single key to load as [S], got %v", status)
+	}
+	if markdown, ok := cfg.Keybindings.Detail["toggleMarkdown"]; !ok || len(markdown) != 0 {
+		t.Errorf("expected toggleMarkdown to be unbound, got %v (set: %v)", markdown, ok)
+	}
+}
+
+func TestLoadViews(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, "lazybeads"), 0755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	configContent := `views:
+  - name: Auth work
+    filter: auth
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "lazybeads", "config.yml"), []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write test config: %v", err)
+	}
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if len(cfg.Views) != 1 || cfg.Views[0].Name != "Auth work" || cfg.Views[0].Filter != "auth" {
+		t.Errorf("unexpected views: %+v", cfg.Views)
+	}
+}
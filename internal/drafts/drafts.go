@@ -0,0 +1,117 @@
+package drafts
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"lazybeads/internal/models"
+	"lazybeads/internal/statedir"
+)
+
+// FormDraft holds the unsaved contents of the create/edit form. Original is
+// the issue as it was when editing started, so only changed fields are saved.
+type FormDraft struct {
+	EditingID          string       `json:"editing_id,omitempty"`
+	Original           *models.Task `json:"original,omitempty"`
+	LinkKind           string       `json:"link_kind,omitempty"`
+	LinkTarget         string       `json:"link_target,omitempty"`
+	Title              string       `json:"title"`
+	Description        string       `json:"description,omitempty"`
+	Notes              string       `json:"notes,omitempty"`
+	Design             string       `json:"design,omitempty"`
+	AcceptanceCriteria string       `json:"acceptance_criteria,omitempty"`
+	Priority           int          `json:"priority"`
+	Type               string       `json:"type"`
+	Labels             string       `json:"labels,omitempty"`
+	Assignee           string       `json:"assignee,omitempty"`
+	Due                string       `json:"due,omitempty"`
+	Defer              string       `json:"defer,omitempty"`
+	SavedAt            time.Time    `json:"saved_at"`
+}
+
+// Store keeps drafts for one project directory under the XDG state dir
+type Store struct {
+	dir string
+}
+
+// NewStore creates a draft store for the given project directory
+func NewStore(projectDir string) *Store {
+	return &Store{dir: filepath.Join(statedir.Dir(), "drafts", statedir.ProjectKey(projectDir))}
+}
+
+// Dir returns the directory drafts for this project are stored in
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+func (s *Store) formPath() string {
+	return filepath.Join(s.dir, "form.json")
+}
+
+// EditorPath returns where editor content for a task field is kept
+func (s *Store) EditorPath(taskID, field string) string {
+	name := strings.NewReplacer("/", "_", string(filepath.Separator), "_").Replace(taskID + "." + field)
+	return filepath.Join(s.dir, "editor", name+".md")
+}
+
+// SaveForm writes the form draft, replacing any previous one
+func (s *Store) SaveForm(draft FormDraft) error {
+	draft.SavedAt = time.Now()
+	data, err := json.MarshalIndent(draft, "", "  ")
+	if err != nil {
+		return err
+	}
+	return statedir.WriteFileAtomic(s.formPath(), data)
+}
+
+// LoadForm returns the saved form draft, or nil if there is none
+func (s *Store) LoadForm() (*FormDraft, error) {
+	data, err := os.ReadFile(s.formPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var draft FormDraft
+	if err := json.Unmarshal(data, &draft); err != nil {
+		return nil, fmt.Errorf("failed to parse form draft: %w", err)
+	}
+	return &draft, nil
+}
+
+// ClearForm removes the form draft
+func (s *Store) ClearForm() error {
+	return removeIfExists(s.formPath())
+}
+
+// SaveEditor keeps content written in the external editor for a task field
+func (s *Store) SaveEditor(taskID, field, content string) error {
+	return statedir.WriteFileAtomic(s.EditorPath(taskID, field), []byte(content))
+}
+
+// LoadEditor returns kept editor content for a task field
+func (s *Store) LoadEditor(taskID, field string) (string, bool) {
+	data, err := os.ReadFile(s.EditorPath(taskID, field))
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+// ClearEditor removes kept editor content for a task field
+func (s *Store) ClearEditor(taskID, field string) error {
+	return removeIfExists(s.EditorPath(taskID, field))
+}
+
+func removeIfExists(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package drafts
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestFormDraftRoundTrip(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	store := NewStore("/projects/example")
+
+	if draft, err := store.LoadForm(); err != nil || draft != nil {
+		t.Fatalf("expected no draft, got %+v (err %v)", draft, err)
+	}
+
+	saved := FormDraft{Title: "Half-written issue", Description: "Line one\nLine two", Priority: 1, Type: "bug", Labels: "ui, docs"}
+	if err := store.SaveForm(saved); err != nil {
+		t.Fatalf("SaveForm failed: %v", err)
+	}
+
+	draft, err := store.LoadForm()
+	if err != nil || draft == nil {
+		t.Fatalf("expected draft, got %+v (err %v)", draft, err)
+	}
+	if draft.Title != saved.Title || draft.Description != saved.Description || draft.Labels != saved.Labels {
+		t.Errorf("draft did not round trip: %+v", draft)
+	}
+	if draft.SavedAt.IsZero() {
+		t.Error("expected SavedAt to be set")
+	}
+
+	if err := store.ClearForm(); err != nil {
+		t.Fatalf("ClearForm failed: %v", err)
+	}
+	if draft, _ := store.LoadForm(); draft != nil {
+		t.Errorf("expected draft to be cleared, got %+v", draft)
+	}
+	if err := store.ClearForm(); err != nil {
+		t.Errorf("clearing a missing draft should not fail: %v", err)
+	}
+}
+
+func TestStoresArePerProject(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	a := NewStore("/projects/a")
+	b := NewStore("/projects/b")
+	if a.Dir() == b.Dir() {
+		t.Fatalf("expected different draft dirs, both are %s", a.Dir())
+	}
+
+	if err := a.SaveForm(FormDraft{Title: "only in a"}); err != nil {
+		t.Fatalf("SaveForm failed: %v", err)
+	}
+	if draft, _ := b.LoadForm(); draft != nil {
+		t.Errorf("draft leaked between projects: %+v", draft)
+	}
+}
+
+func TestEditorDraft(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	store := NewStore("/projects/example")
+
+	if _, ok := store.LoadEditor("lazybeads-1", "description"); ok {
+		t.Fatal("expected no editor draft")
+	}
+	if err := store.SaveEditor("lazybeads-1", "description", "kept text"); err != nil {
+		t.Fatalf("SaveEditor failed: %v", err)
+	}
+
+	content, ok := store.LoadEditor("lazybeads-1", "description")
+	if !ok || content != "kept text" {
+		t.Errorf("expected kept text, got %q (ok %v)", content, ok)
+	}
+	if !strings.HasPrefix(store.EditorPath("lazybeads-1", "description"), store.Dir()) {
+		t.Errorf("editor draft should live in the store dir")
+	}
+
+	if err := store.ClearEditor("lazybeads-1", "description"); err != nil {
+		t.Fatalf("ClearEditor failed: %v", err)
+	}
+	if _, err := os.Stat(store.EditorPath("lazybeads-1", "description")); !os.IsNotExist(err) {
+		t.Errorf("expected editor draft to be removed")
+	}
+}
@@ -7,30 +7,92 @@ import (
 
 // Task represents a beads issue
 type Task struct {
-	ID                 string     `json:"id"`
-	Title              string     `json:"title"`
-	Description        string     `json:"description,omitempty"`
-	Notes              string     `json:"notes,omitempty"`
-	Design             string     `json:"design,omitempty"`
-	AcceptanceCriteria string     `json:"acceptance_criteria,omitempty"`
-	Status             string     `json:"status"`
-	Priority           int        `json:"priority"`
-	Type               string     `json:"issue_type"`
-	Labels             []string   `json:"labels,omitempty"`
-	Assignee           string     `json:"assignee,omitempty"`
-	CreatedAt          time.Time  `json:"created_at"`
-	CreatedBy          string     `json:"created_by,omitempty"`
-	UpdatedAt          time.Time  `json:"updated_at"`
-	ClosedAt           *time.Time `json:"closed_at,omitempty"`
-	CloseReason        string     `json:"close_reason,omitempty"`
-	DueDate            *time.Time `json:"due_date,omitempty"`
-	DeferUntil         *time.Time `json:"defer_until,omitempty"`
-	BlockedBy          []string   `json:"blocked_by,omitempty"`
-	BlockingDepth      int        `json:"blocking_depth,omitempty"`
-	TreePrefix         string     `json:"-"`
-	Blocks             []string   `json:"blocks,omitempty"`
-	DependencyCount    int        `json:"dependency_count,omitempty"`
-	DependentCount     int        `json:"dependent_count,omitempty"`
+	ID                 string       `json:"id"`
+	Title              string       `json:"title"`
+	Description        string       `json:"description,omitempty"`
+	Notes              string       `json:"notes,omitempty"`
+	Design             string       `json:"design,omitempty"`
+	AcceptanceCriteria string       `json:"acceptance_criteria,omitempty"`
+	Status             string       `json:"status"`
+	Priority           int          `json:"priority"`
+	Type               string       `json:"issue_type"`
+	Labels             []string     `json:"labels,omitempty"`
+	Assignee           string       `json:"assignee,omitempty"`
+	CreatedAt          time.Time    `json:"created_at"`
+	CreatedBy          string       `json:"created_by,omitempty"`
+	UpdatedAt          time.Time    `json:"updated_at"`
+	ClosedAt           *time.Time   `json:"closed_at,omitempty"`
+	CloseReason        string       `json:"close_reason,omitempty"`
+	DueDate            *time.Time   `json:"due_date,omitempty"`
+	DeferUntil         *time.Time   `json:"defer_until,omitempty"`
+	BlockedBy          []string     `json:"blocked_by,omitempty"`
+	BlockingDepth      int          `json:"blocking_depth,omitempty"`
+	TreePrefix         string       `json:"-"`
+	Blocks             []string     `json:"blocks,omitempty"`
+	DependencyCount    int          `json:"dependency_count,omitempty"`
+	DependentCount     int          `json:"dependent_count,omitempty"`
+	Dependencies       []Dependency `json:"dependencies,omitempty"`
+	Parent             string       `json:"parent,omitempty"`
+	Children           []string     `json:"-"`
+	ChildrenClosed     int          `json:"-"`
+	Expanded           bool         `json:"-"`
+	ChecklistDone      int          `json:"-"`
+	ChecklistTotal     int          `json:"-"`
+	Changed            bool         `json:"-"` // changed since an earlier refresh
+}
+
+// Dependency types used by bd
+const (
+	DependencyBlocks      = "blocks"
+	DependencyParentChild = "parent-child"
+)
+
+// Dependency is a typed link from one issue to another it depends on
+type Dependency struct {
+	IssueID     string `json:"issue_id"`
+	DependsOnID string `json:"depends_on_id"`
+	Type        string `json:"type"`
+}
+
+// Comment is a comment left on an issue
+type Comment struct {
+	ID        int64     `json:"id"`
+	IssueID   string    `json:"issue_id"`
+	Author    string    `json:"author"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Event is one entry in an issue's audit trail. For "updated" events the
+// old and new values hold JSON objects of the changed fields.
+type Event struct {
+	ID        int64     `json:"id"`
+	IssueID   string    `json:"issue_id"`
+	Type      string    `json:"event_type"`
+	Actor     string    `json:"actor"`
+	OldValue  *string   `json:"old_value,omitempty"`
+	NewValue  *string   `json:"new_value,omitempty"`
+	Comment   *string   `json:"comment,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ParentID returns the parent issue ID from either the parent field or a
+// parent-child dependency
+func (t Task) ParentID() string {
+	if t.Parent != "" {
+		return t.Parent
+	}
+	for _, dep := range t.Dependencies {
+		if dep.Type == DependencyParentChild && dep.DependsOnID != "" {
+			return dep.DependsOnID
+		}
+	}
+	return ""
+}
+
+// IsEpic returns true if the task is an epic
+func (t Task) IsEpic() bool {
+	return t.Type == "epic"
 }
 
 // PriorityString returns a short priority label
@@ -51,7 +113,8 @@ func (t Task) PriorityString() string {
 	}
 }
 
-// StatusIcon returns a status indicator
+// StatusIcon returns a status indicator. The interface draws with
+// ui.StatusIcon, which follows the display mode.
 func (t Task) StatusIcon() string {
 	switch t.Status {
 	case "open":
@@ -0,0 +1,66 @@
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"lazybeads/internal/statedir"
+)
+
+// State is where the user left the interface in a project
+type State struct {
+	FocusedPanel  string    `json:"focused_panel,omitempty"` // in_progress, open or closed
+	SelectedID    string    `json:"selected_id,omitempty"`
+	PanelAdjust   int       `json:"panel_adjust,omitempty"`
+	Filter        string    `json:"filter,omitempty"`
+	ExpandedEpics []string  `json:"expanded_epics,omitempty"`
+	PaletteRecent []string  `json:"palette_recent,omitempty"` // most recent first
+	SavedAt       time.Time `json:"saved_at"`
+}
+
+// Store keeps the session state of one project directory under the XDG
+// state dir
+type Store struct {
+	path string
+}
+
+// NewStore creates a session store for the given project directory
+func NewStore(projectDir string) *Store {
+	return &Store{path: filepath.Join(statedir.Dir(), "sessions", statedir.ProjectKey(projectDir)+".json")}
+}
+
+// Path returns the file the session state is kept in
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Load returns the saved state, or nil if there is none
+func (s *Store) Load() (*State, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse session state: %w", err)
+	}
+	return &state, nil
+}
+
+// Save writes the state, replacing the previous one
+func (s *Store) Save(state State) error {
+	state.SavedAt = time.Now()
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return statedir.WriteFileAtomic(s.path, data)
+}
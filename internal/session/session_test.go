@@ -0,0 +1,38 @@
+package session
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestStateRoundTrip(t *testing.T) {
+	stateHome := t.TempDir()
+	t.Setenv("XDG_STATE_HOME", stateHome)
+	store := NewStore("/projects/example")
+
+	if !strings.HasPrefix(store.Path(), filepath.Join(stateHome, "lazybeads", "sessions")) {
+		t.Errorf("expected the session under the state dir, got %s", store.Path())
+	}
+	if state, err := store.Load(); err != nil || state != nil {
+		t.Fatalf("expected no state, got %+v (err %v)", state, err)
+	}
+
+	saved := State{FocusedPanel: "closed", SelectedID: "lazybeads-7", PanelAdjust: -10, Filter: "auth", ExpandedEpics: []string{"lazybeads-1"}, PaletteRecent: []string{"action:refresh"}}
+	if err := store.Save(saved); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	state, err := store.Load()
+	if err != nil || state == nil {
+		t.Fatalf("expected state, got %+v (err %v)", state, err)
+	}
+	if state.FocusedPanel != "closed" || state.SelectedID != "lazybeads-7" || state.PanelAdjust != -10 ||
+		state.Filter != "auth" || len(state.ExpandedEpics) != 1 || len(state.PaletteRecent) != 1 || state.SavedAt.IsZero() {
+		t.Errorf("state did not round trip: %+v", state)
+	}
+
+	if other := NewStore("/projects/other"); other.Path() == store.Path() {
+		t.Error("expected each project to have its own session")
+	}
+}
@@ -0,0 +1,55 @@
+package statedir
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+)
+
+// Dir returns the lazybeads state directory
+// ($XDG_STATE_HOME/lazybeads, defaulting to ~/.local/state/lazybeads)
+func Dir() string {
+	if xdgState := os.Getenv("XDG_STATE_HOME"); xdgState != "" {
+		return filepath.Join(xdgState, "lazybeads")
+	}
+
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".local", "state", "lazybeads")
+}
+
+// ProjectKey names the state kept for a project directory
+func ProjectKey(projectDir string) string {
+	if abs, err := filepath.Abs(projectDir); err == nil {
+		projectDir = abs
+	}
+	sum := sha1.Sum([]byte(projectDir))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// WriteFileAtomic writes data to a temp file next to path and renames it
+// into place, so a crash never leaves a half-written file behind
+func WriteFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package statedir
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDir(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_STATE_HOME", tmpDir)
+
+	expected := filepath.Join(tmpDir, "lazybeads")
+	if got := Dir(); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestProjectKey(t *testing.T) {
+	if ProjectKey("/projects/a") == ProjectKey("/projects/b") {
+		t.Error("expected each project to have its own key")
+	}
+	if key := ProjectKey("/projects/a"); len(key) != 12 {
+		t.Errorf("expected a 12 character key, got %q", key)
+	}
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "state.json")
+	for _, content := range []string{"first", "second"} {
+		if err := WriteFileAtomic(path, []byte(content)); err != nil {
+			t.Fatalf("WriteFileAtomic failed: %v", err)
+		}
+	}
+
+	if data, err := os.ReadFile(path); err != nil || string(data) != "second" {
+		t.Errorf("expected the file replaced, got %q (err %v)", data, err)
+	}
+	if entries, _ := os.ReadDir(filepath.Dir(path)); len(entries) != 1 {
+		t.Errorf("expected no temp files left behind, got %v", entries)
+	}
+
+	// A directory in the way makes the rename fail
+	blocked := filepath.Join(t.TempDir(), "taken")
+	if err := os.MkdirAll(filepath.Join(blocked, "child"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFileAtomic(blocked, []byte("x")); err == nil {
+		t.Error("expected renaming over a directory to fail")
+	}
+	if entries, _ := os.ReadDir(filepath.Dir(blocked)); len(entries) != 1 {
+		t.Errorf("expected the temp file removed after a failed rename, got %v", entries)
+	}
+}
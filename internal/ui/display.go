@@ -0,0 +1,189 @@
+package ui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// Display selects how the interface is drawn on limited terminals
+type Display struct {
+	ASCII   bool // ASCII-only markers, borders and tree prefixes
+	NoColor bool // no colors; selection and state are shown as text
+}
+
+// GlyphSet holds the symbols the interface is drawn with
+type GlyphSet struct {
+	Border       lipgloss.Border // panels, modals and inputs
+	ButtonBorder lipgloss.Border
+
+	TreeBranch string // child in the middle of a tree
+	TreeLast   string // last child of a tree
+	TreePipe   string // continues a tree past a child's subtree
+	Expanded   string // expanded epic
+	Collapsed  string // collapsed epic
+
+	Blocked   string // row marker of blocked issues
+	Deferred  string // row marker of deferred issues
+	Changed   string // row marker of issues changed by a refresh
+	Checked   string
+	Unchecked string
+	Tick      string // checklist progress badge
+	Cursor    string
+	Bullet    string
+
+	Arrow     string // from → to
+	DownArrow string
+	LeftRight string // arrow keys in key hints
+	Scroll    string // more lines than shown
+	Ellipsis  string
+	Warning   string
+	Separator string // between tabs
+	Dot       string // between inline details
+	Empty     string // an empty value in a field change
+
+	BarFull  string // filled part of progress bars
+	BarEmpty string
+	Rule     string // horizontal rule
+	Quote    string // block quote prefix
+
+	StatusOpen       string
+	StatusInProgress string
+	StatusClosed     string
+}
+
+// UnicodeGlyphs draws with box-drawing characters and symbols
+var UnicodeGlyphs = GlyphSet{
+	Border:       lipgloss.RoundedBorder(),
+	ButtonBorder: lipgloss.NormalBorder(),
+	TreeBranch:   "├─ ",
+	TreeLast:     "└─ ",
+	TreePipe:     "│  ",
+	Expanded:     "▾ ",
+	Collapsed:    "▸ ",
+	Blocked:      "⛔",
+	Deferred:     "⏳",
+	Changed:      "✦",
+	Checked:      "☑",
+	Unchecked:    "☐",
+	Tick:         "☑",
+	Cursor:       "▶ ",
+	Bullet:       "•",
+	Arrow:        "→",
+	DownArrow:    "↓",
+	LeftRight:    "←/→",
+	Scroll:       "↕",
+	Ellipsis:     "…",
+	Warning:      "⚠",
+	Separator:    "│",
+	Dot:          "·",
+	Empty:        "∅",
+	BarFull:      "█",
+	BarEmpty:     "░",
+	Rule:         "─",
+	Quote:        "│ ",
+
+	StatusOpen:       "○",
+	StatusInProgress: "◐",
+	StatusClosed:     "●",
+}
+
+// ASCIIGlyphs draws with printable ASCII only
+var ASCIIGlyphs = GlyphSet{
+	Border:       lipgloss.ASCIIBorder(),
+	ButtonBorder: lipgloss.ASCIIBorder(),
+	TreeBranch:   "|- ",
+	TreeLast:     "`- ",
+	TreePipe:     "|  ",
+	Expanded:     "- ",
+	Collapsed:    "+ ",
+	Blocked:      "!",
+	Deferred:     "~",
+	Changed:      "*",
+	Checked:      "[x]",
+	Unchecked:    "[ ]",
+	Tick:         "x",
+	Cursor:       "> ",
+	Bullet:       "*",
+	Arrow:        "->",
+	DownArrow:    "v",
+	LeftRight:    "left/right",
+	Scroll:       "^v",
+	Ellipsis:     "...",
+	Warning:      "!",
+	Separator:    "|",
+	Dot:          "-",
+	Empty:        "(none)",
+	BarFull:      "#",
+	BarEmpty:     ".",
+	Rule:         "-",
+	Quote:        "> ",
+
+	StatusOpen:       "o",
+	StatusInProgress: "~",
+	StatusClosed:     "x",
+}
+
+// Glyphs are the symbols of the current display mode
+var Glyphs = UnicodeGlyphs
+
+var display Display
+
+// colorProfile is the profile in use before no-color mode replaced it
+var colorProfile termenv.Profile
+
+// DisplayFromEnv enables ASCII and no-color mode for TERM=dumb, and
+// no-color mode when NO_COLOR is set (https://no-color.org)
+func DisplayFromEnv(getenv func(string) string) Display {
+	dumb := getenv("TERM") == "dumb"
+	return Display{
+		ASCII:   dumb,
+		NoColor: dumb || getenv("NO_COLOR") != "",
+	}
+}
+
+// Merge enables every mode enabled in either display
+func (d Display) Merge(other Display) Display {
+	return Display{ASCII: d.ASCII || other.ASCII, NoColor: d.NoColor || other.NoColor}
+}
+
+// CurrentDisplay returns the display mode in use
+func CurrentDisplay() Display {
+	return display
+}
+
+// NoColor reports whether colors are off, so state must be shown as text
+func NoColor() bool {
+	return display.NoColor
+}
+
+// SetDisplay switches the display mode and rebuilds the styles
+func SetDisplay(d Display) {
+	switch {
+	case d.NoColor && !display.NoColor:
+		colorProfile = lipgloss.ColorProfile()
+		lipgloss.SetColorProfile(termenv.Ascii)
+	case !d.NoColor && display.NoColor:
+		lipgloss.SetColorProfile(colorProfile)
+	}
+	display = d
+	if d.ASCII {
+		Glyphs = ASCIIGlyphs
+	} else {
+		Glyphs = UnicodeGlyphs
+	}
+	buildStyles()
+	buildMarkdownStyles()
+}
+
+// StatusIcon returns the status indicator of an issue
+func StatusIcon(status string) string {
+	switch status {
+	case "open":
+		return Glyphs.StatusOpen
+	case "in_progress":
+		return Glyphs.StatusInProgress
+	case "closed":
+		return Glyphs.StatusClosed
+	}
+	return "?"
+}
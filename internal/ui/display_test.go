@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestDisplayFromEnv(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(key string) string { return vars[key] }
+	}
+
+	if d := DisplayFromEnv(env(map[string]string{"TERM": "xterm-256color"})); d.ASCII || d.NoColor {
+		t.Errorf("expected the default display, got %+v", d)
+	}
+	if d := DisplayFromEnv(env(map[string]string{"NO_COLOR": "1"})); d.ASCII || !d.NoColor {
+		t.Errorf("expected only no-color for NO_COLOR, got %+v", d)
+	}
+	if d := DisplayFromEnv(env(map[string]string{"TERM": "dumb"})); !d.ASCII || !d.NoColor {
+		t.Errorf("expected ASCII and no-color for TERM=dumb, got %+v", d)
+	}
+}
+
+func TestSetDisplayASCII(t *testing.T) {
+	defer SetDisplay(Display{})
+
+	SetDisplay(Display{ASCII: true})
+	if StatusIcon("in_progress") != "~" || Glyphs.TreeLast != "`- " {
+		t.Errorf("expected ASCII glyphs, got %q and %q", StatusIcon("in_progress"), Glyphs.TreeLast)
+	}
+	box := OverlayStyle.Render("x")
+	for _, r := range box {
+		if r > 127 {
+			t.Fatalf("expected an ASCII border, got %q", box)
+		}
+	}
+
+	SetDisplay(Display{})
+	if StatusIcon("in_progress") != "◐" {
+		t.Errorf("expected unicode glyphs back, got %q", StatusIcon("in_progress"))
+	}
+}
+
+func TestSetDisplayRestoresColorProfile(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(previous)
+	defer SetDisplay(Display{})
+
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	SetDisplay(Display{NoColor: true})
+	if lipgloss.ColorProfile() != termenv.Ascii {
+		t.Fatalf("expected no colors, got profile %v", lipgloss.ColorProfile())
+	}
+	SetDisplay(Display{NoColor: true, ASCII: true})
+
+	SetDisplay(Display{})
+	if lipgloss.ColorProfile() != termenv.TrueColor {
+		t.Errorf("expected the color profile restored, got %v", lipgloss.ColorProfile())
+	}
+}
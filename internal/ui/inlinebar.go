@@ -117,10 +117,10 @@ func (b InlineBar) InputValue() string {
 func (b InlineBar) View(width int) string {
 	var content strings.Builder
 
-	// Colors for light background bar (vim/tmux inspired)
-	barBg := lipgloss.Color("7")    // White/light background
-	darkText := lipgloss.Color("0") // Black text
-	accentText := lipgloss.Color("4") // Blue for accents
+	// Bar colors come from the theme (vim/tmux inspired status bar)
+	barBg := ColorBar
+	darkText := ColorBarText
+	accentText := ColorSecondary
 
 	// Title and subtitle
 	titleStyle := lipgloss.NewStyle().
@@ -1,6 +1,11 @@
 package ui
 
-import "github.com/charmbracelet/bubbles/key"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+)
 
 // KeyMap defines all keybindings
 type KeyMap struct {
@@ -13,12 +18,22 @@ type KeyMap struct {
 	PageDown key.Binding
 
 	// Actions
-	Select  key.Binding
-	Add     key.Binding
-	Delete  key.Binding
-	Refresh key.Binding
+	Select       key.Binding
+	Add          key.Binding
+	Delete       key.Binding
+	AddLinked    key.Binding
+	QuickCapture key.Binding
+	Refresh      key.Binding
+	Cycles       key.Binding
+	Analysis     key.Binding
+	Changes      key.Binding
+
+	// Epics
+	ToggleExpand key.Binding
+	AddChild     key.Binding
 
 	// Field-specific editing
+	EditAll         key.Binding
 	EditTitle       key.Binding
 	EditStatus      key.Binding
 	EditPriority    key.Binding
@@ -27,8 +42,22 @@ type KeyMap struct {
 	EditNotes       key.Binding
 	EditDesign      key.Binding
 	EditAcceptance  key.Binding
+	Checklist       key.Binding
 	EditFormField   key.Binding
 	CopyID          key.Binding
+	OpenLink        key.Binding
+
+	// Detail pane
+	NextTab    key.Binding
+	PrevTab    key.Binding
+	DetailDown key.Binding
+	DetailUp   key.Binding
+
+	// Issue links in the detail view
+	NextLink    key.Binding
+	PrevLink    key.Binding
+	HistoryBack key.Binding
+	HistoryFwd  key.Binding
 
 	// Filtering
 	Filter     key.Binding
@@ -38,16 +67,19 @@ type KeyMap struct {
 	All        key.Binding
 
 	// UI
-	Help        key.Binding
-	Quit        key.Binding
-	Cancel      key.Binding
-	Submit      key.Binding
-	Tab         key.Binding
-	ShiftTab    key.Binding
-	PrevView    key.Binding
-	NextView    key.Binding
-	PanelShrink key.Binding
-	PanelExpand key.Binding
+	Help           key.Binding
+	Palette        key.Binding
+	GotoIssue      key.Binding
+	ToggleMarkdown key.Binding
+	Quit           key.Binding
+	Cancel         key.Binding
+	Submit         key.Binding
+	Tab            key.Binding
+	ShiftTab       key.Binding
+	PrevView       key.Binding
+	NextView       key.Binding
+	PanelShrink    key.Binding
+	PanelExpand    key.Binding
 
 	// Custom commands (loaded from config)
 	CustomCommands []key.Binding
@@ -95,12 +127,46 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("x"),
 			key.WithHelp("x", "delete"),
 		),
+		AddLinked: key.NewBinding(
+			key.WithKeys("L"),
+			key.WithHelp("L", "add linked issue"),
+		),
+		QuickCapture: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "quick capture"),
+		),
 		Refresh: key.NewBinding(
 			key.WithKeys("R"),
 			key.WithHelp("R", "refresh"),
 		),
+		Cycles: key.NewBinding(
+			key.WithKeys("!"),
+			key.WithHelp("!", "dependency cycles"),
+		),
+		Analysis: key.NewBinding(
+			key.WithKeys("I"),
+			key.WithHelp("I", "planning analysis"),
+		),
+		Changes: key.NewBinding(
+			key.WithKeys("W"),
+			key.WithHelp("W", "recent changes"),
+		),
+
+		// Epics
+		ToggleExpand: key.NewBinding(
+			key.WithKeys(" "),
+			key.WithHelp("space", "expand/collapse epic"),
+		),
+		AddChild: key.NewBinding(
+			key.WithKeys("+"),
+			key.WithHelp("+", "add child issue"),
+		),
 
 		// Field-specific editing
+		EditAll: key.NewBinding(
+			key.WithKeys("E"),
+			key.WithHelp("E", "edit all fields"),
+		),
 		EditTitle: key.NewBinding(
 			key.WithKeys("e"),
 			key.WithHelp("e", "edit title"),
@@ -121,6 +187,10 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("y"),
 			key.WithHelp("y", "copy id"),
 		),
+		OpenLink: key.NewBinding(
+			key.WithKeys("O"),
+			key.WithHelp("O", "open url or file"),
+		),
 		EditDescription: key.NewBinding(
 			key.WithKeys("d"),
 			key.WithHelp("d", "edit description"),
@@ -137,11 +207,52 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("C"),
 			key.WithHelp("C", "edit acceptance criteria"),
 		),
+		Checklist: key.NewBinding(
+			key.WithKeys("X"),
+			key.WithHelp("X", "tick checklist items"),
+		),
 		EditFormField: key.NewBinding(
 			key.WithKeys("ctrl+e"),
 			key.WithHelp("^e", "edit field in editor"),
 		),
 
+		// Detail pane
+		NextTab: key.NewBinding(
+			key.WithKeys("]"),
+			key.WithHelp("]", "next detail tab"),
+		),
+		PrevTab: key.NewBinding(
+			key.WithKeys("["),
+			key.WithHelp("[", "previous detail tab"),
+		),
+		DetailDown: key.NewBinding(
+			key.WithKeys("J"),
+			key.WithHelp("J", "scroll detail down"),
+		),
+		DetailUp: key.NewBinding(
+			key.WithKeys("K"),
+			key.WithHelp("K", "scroll detail up"),
+		),
+
+		// Issue links in the detail view
+		NextLink: key.NewBinding(
+			key.WithKeys("}"),
+			key.WithHelp("}", "next issue link"),
+		),
+		PrevLink: key.NewBinding(
+			key.WithKeys("{"),
+			key.WithHelp("{", "previous issue link"),
+		),
+		HistoryBack: key.NewBinding(
+			key.WithKeys("ctrl+o"),
+			key.WithHelp("^o", "back to previous issue"),
+		),
+		HistoryFwd: key.NewBinding(
+			// Terminals send tab for ctrl+i
+			key.WithKeys("ctrl+i", "tab"),
+			key.WithHelp("^i", "forward to next issue"),
+		),
+
 		// Filtering
 		Filter: key.NewBinding(
 			key.WithKeys("/"),
@@ -169,6 +280,18 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("?"),
 			key.WithHelp("?", "help"),
 		),
+		Palette: key.NewBinding(
+			key.WithKeys("ctrl+p"),
+			key.WithHelp("^p", "command palette"),
+		),
+		GotoIssue: key.NewBinding(
+			key.WithKeys(":"),
+			key.WithHelp(":", "go to issue"),
+		),
+		ToggleMarkdown: key.NewBinding(
+			key.WithKeys("M"),
+			key.WithHelp("M", "toggle markdown rendering"),
+		),
 		Quit: key.NewBinding(
 			key.WithKeys("q", "ctrl+c"),
 			key.WithHelp("q", "quit"),
@@ -208,6 +331,122 @@ func DefaultKeyMap() KeyMap {
 	}
 }
 
+// keyActions names every KeyMap binding as it is written in config.yml
+var keyActions = []struct {
+	name    string
+	binding func(*KeyMap) *key.Binding
+}{
+	{"up", func(k *KeyMap) *key.Binding { return &k.Up }},
+	{"down", func(k *KeyMap) *key.Binding { return &k.Down }},
+	{"top", func(k *KeyMap) *key.Binding { return &k.Top }},
+	{"bottom", func(k *KeyMap) *key.Binding { return &k.Bottom }},
+	{"pageUp", func(k *KeyMap) *key.Binding { return &k.PageUp }},
+	{"pageDown", func(k *KeyMap) *key.Binding { return &k.PageDown }},
+	{"select", func(k *KeyMap) *key.Binding { return &k.Select }},
+	{"add", func(k *KeyMap) *key.Binding { return &k.Add }},
+	{"delete", func(k *KeyMap) *key.Binding { return &k.Delete }},
+	{"addLinked", func(k *KeyMap) *key.Binding { return &k.AddLinked }},
+	{"quickCapture", func(k *KeyMap) *key.Binding { return &k.QuickCapture }},
+	{"refresh", func(k *KeyMap) *key.Binding { return &k.Refresh }},
+	{"cycles", func(k *KeyMap) *key.Binding { return &k.Cycles }},
+	{"analysis", func(k *KeyMap) *key.Binding { return &k.Analysis }},
+	{"changes", func(k *KeyMap) *key.Binding { return &k.Changes }},
+	{"toggleExpand", func(k *KeyMap) *key.Binding { return &k.ToggleExpand }},
+	{"addChild", func(k *KeyMap) *key.Binding { return &k.AddChild }},
+	{"editAll", func(k *KeyMap) *key.Binding { return &k.EditAll }},
+	{"editTitle", func(k *KeyMap) *key.Binding { return &k.EditTitle }},
+	{"editStatus", func(k *KeyMap) *key.Binding { return &k.EditStatus }},
+	{"editPriority", func(k *KeyMap) *key.Binding { return &k.EditPriority }},
+	{"editType", func(k *KeyMap) *key.Binding { return &k.EditType }},
+	{"editDescription", func(k *KeyMap) *key.Binding { return &k.EditDescription }},
+	{"editNotes", func(k *KeyMap) *key.Binding { return &k.EditNotes }},
+	{"editDesign", func(k *KeyMap) *key.Binding { return &k.EditDesign }},
+	{"editAcceptance", func(k *KeyMap) *key.Binding { return &k.EditAcceptance }},
+	{"checklist", func(k *KeyMap) *key.Binding { return &k.Checklist }},
+	{"editFormField", func(k *KeyMap) *key.Binding { return &k.EditFormField }},
+	{"copyID", func(k *KeyMap) *key.Binding { return &k.CopyID }},
+	{"openLink", func(k *KeyMap) *key.Binding { return &k.OpenLink }},
+	{"nextTab", func(k *KeyMap) *key.Binding { return &k.NextTab }},
+	{"prevTab", func(k *KeyMap) *key.Binding { return &k.PrevTab }},
+	{"detailDown", func(k *KeyMap) *key.Binding { return &k.DetailDown }},
+	{"detailUp", func(k *KeyMap) *key.Binding { return &k.DetailUp }},
+	{"nextLink", func(k *KeyMap) *key.Binding { return &k.NextLink }},
+	{"prevLink", func(k *KeyMap) *key.Binding { return &k.PrevLink }},
+	{"historyBack", func(k *KeyMap) *key.Binding { return &k.HistoryBack }},
+	{"historyFwd", func(k *KeyMap) *key.Binding { return &k.HistoryFwd }},
+	{"filter", func(k *KeyMap) *key.Binding { return &k.Filter }},
+	{"filterDone", func(k *KeyMap) *key.Binding { return &k.FilterDone }},
+	{"ready", func(k *KeyMap) *key.Binding { return &k.Ready }},
+	{"open", func(k *KeyMap) *key.Binding { return &k.Open }},
+	{"all", func(k *KeyMap) *key.Binding { return &k.All }},
+	{"help", func(k *KeyMap) *key.Binding { return &k.Help }},
+	{"palette", func(k *KeyMap) *key.Binding { return &k.Palette }},
+	{"gotoIssue", func(k *KeyMap) *key.Binding { return &k.GotoIssue }},
+	{"toggleMarkdown", func(k *KeyMap) *key.Binding { return &k.ToggleMarkdown }},
+	{"quit", func(k *KeyMap) *key.Binding { return &k.Quit }},
+	{"cancel", func(k *KeyMap) *key.Binding { return &k.Cancel }},
+	{"submit", func(k *KeyMap) *key.Binding { return &k.Submit }},
+	{"tab", func(k *KeyMap) *key.Binding { return &k.Tab }},
+	{"shiftTab", func(k *KeyMap) *key.Binding { return &k.ShiftTab }},
+	{"prevView", func(k *KeyMap) *key.Binding { return &k.PrevView }},
+	{"nextView", func(k *KeyMap) *key.Binding { return &k.NextView }},
+	{"panelShrink", func(k *KeyMap) *key.Binding { return &k.PanelShrink }},
+	{"panelExpand", func(k *KeyMap) *key.Binding { return &k.PanelExpand }},
+}
+
+// KeyActions returns the names of all bindings that can be remapped
+func KeyActions() []string {
+	names := make([]string, len(keyActions))
+	for i, action := range keyActions {
+		names[i] = action.name
+	}
+	return names
+}
+
+// Binding returns the binding of a named action, or nil for unknown names
+func (k *KeyMap) Binding(action string) *key.Binding {
+	for _, a := range keyActions {
+		if a.name == action {
+			return a.binding(k)
+		}
+	}
+	return nil
+}
+
+// Rebind replaces the keys of a named action and updates its help text.
+// Passing no keys unbinds the action.
+func (k *KeyMap) Rebind(action string, keys []string) error {
+	b := k.Binding(action)
+	if b == nil {
+		return fmt.Errorf("unknown action %q", action)
+	}
+	desc := b.Help().Desc
+	b.SetKeys(keys...)
+	b.SetEnabled(len(keys) > 0)
+	if len(keys) == 0 {
+		b.SetHelp("", desc)
+		return nil
+	}
+	b.SetHelp(helpKeyNames(keys), desc)
+	return nil
+}
+
+// helpKeyNames formats keys the way the default help text does
+func helpKeyNames(keys []string) string {
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		switch {
+		case k == " ":
+			names[i] = "space"
+		case strings.HasPrefix(k, "ctrl+"):
+			names[i] = "^" + strings.TrimPrefix(k, "ctrl+")
+		default:
+			names[i] = k
+		}
+	}
+	return strings.Join(names, "/")
+}
+
 // ShortHelp returns keybindings for compact help view
 func (k KeyMap) ShortHelp() []key.Binding {
 	return []key.Binding{
@@ -225,13 +464,16 @@ func (k KeyMap) ShortHelp() []key.Binding {
 func (k KeyMap) FullHelp() [][]key.Binding {
 	groups := [][]key.Binding{
 		{k.Up, k.Down, k.Top, k.Bottom, k.PageUp, k.PageDown},
-		{k.Select, k.Add, k.Delete, k.Refresh},
-		{k.EditTitle, k.EditStatus, k.EditPriority, k.EditType},
-		{k.EditDescription, k.EditNotes, k.EditDesign, k.EditAcceptance, k.EditFormField, k.CopyID},
+		{k.Select, k.Add, k.AddLinked, k.QuickCapture, k.Delete, k.Refresh, k.Cycles, k.Analysis, k.Changes},
+		{k.ToggleExpand, k.AddChild},
+		{k.EditAll, k.EditTitle, k.EditStatus, k.EditPriority, k.EditType},
+		{k.EditDescription, k.EditNotes, k.EditDesign, k.EditAcceptance, k.Checklist, k.EditFormField, k.CopyID, k.OpenLink},
+		{k.NextTab, k.PrevTab, k.DetailDown, k.DetailUp},
+		{k.NextLink, k.PrevLink, k.HistoryBack, k.HistoryFwd},
 		{k.Filter, k.Ready, k.Open, k.All},
 		{k.Submit, k.Tab, k.ShiftTab},
 		{k.PrevView, k.NextView, k.PanelShrink, k.PanelExpand},
-		{k.Help, k.Quit, k.Cancel},
+		{k.Help, k.Palette, k.GotoIssue, k.ToggleMarkdown, k.Quit, k.Cancel},
 	}
 	// Add custom commands as a separate group if present
 	if len(k.CustomCommands) > 0 {
@@ -0,0 +1,427 @@
+package ui
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Markdown styles for the detail pane
+var (
+	MarkdownH1Style        lipgloss.Style
+	MarkdownH2Style        lipgloss.Style
+	MarkdownHeadingStyle   lipgloss.Style
+	MarkdownBoldStyle      lipgloss.Style
+	MarkdownItalicStyle    lipgloss.Style
+	MarkdownStrikeStyle    lipgloss.Style
+	MarkdownCodeStyle      lipgloss.Style
+	MarkdownCodeBlockStyle lipgloss.Style
+	MarkdownQuoteStyle     lipgloss.Style
+	MarkdownLinkStyle      lipgloss.Style
+	MarkdownMutedStyle     lipgloss.Style
+	MarkdownCheckedStyle   lipgloss.Style
+)
+
+// buildMarkdownStyles creates the markdown styles from the current colors
+func buildMarkdownStyles() {
+	MarkdownH1Style = lipgloss.NewStyle().
+		Foreground(ColorPrimary).
+		Bold(true).
+		Underline(true)
+
+	MarkdownH2Style = lipgloss.NewStyle().
+		Foreground(ColorPrimary).
+		Bold(true)
+
+	MarkdownHeadingStyle = lipgloss.NewStyle().
+		Foreground(ColorSecondary).
+		Bold(true)
+
+	MarkdownBoldStyle = lipgloss.NewStyle().Bold(true)
+	MarkdownItalicStyle = lipgloss.NewStyle().Italic(true)
+	MarkdownStrikeStyle = lipgloss.NewStyle().Strikethrough(true)
+
+	MarkdownCodeStyle = lipgloss.NewStyle().
+		Foreground(ColorAccent)
+
+	MarkdownCodeBlockStyle = lipgloss.NewStyle().
+		Foreground(ColorAccent)
+
+	MarkdownQuoteStyle = lipgloss.NewStyle().
+		Foreground(ColorMuted).
+		Italic(true)
+
+	MarkdownLinkStyle = lipgloss.NewStyle().
+		Foreground(ColorSecondary).
+		Underline(true)
+
+	MarkdownMutedStyle = lipgloss.NewStyle().Foreground(ColorMuted)
+	MarkdownCheckedStyle = lipgloss.NewStyle().Foreground(ColorPrimary)
+}
+
+var (
+	mdHeadingRe  = regexp.MustCompile(`^(#{1,6})\s+(.*?)(\s+#+)?\s*$`)
+	mdRuleRe     = regexp.MustCompile(`^([-*_])(\s*([-*_])){2,}\s*$`)
+	mdListRe     = regexp.MustCompile(`^(\s*)([-*+]|\d{1,9}[.)])\s+(.*)$`)
+	mdCheckboxRe = regexp.MustCompile(`^\[([ xX])\]\s+(.*)$`)
+	mdFenceRe    = regexp.MustCompile("^(\\s*)(`{3,}|~{3,})\\s*([^`\\s]*)")
+)
+
+type mdBlockKind int
+
+const (
+	mdParagraph mdBlockKind = iota
+	mdQuote
+	mdListItem
+)
+
+// mdBlock is a paragraph, quote or list item collected across source lines
+type mdBlock struct {
+	kind  mdBlockKind
+	first string // prefix for the first wrapped line
+	rest  string // prefix for continuation lines
+	style *lipgloss.Style
+	lines []string
+}
+
+// RenderMarkdown renders markdown for the terminal, wrapped to width. It
+// handles headings, emphasis, inline code, fenced code blocks, lists, task
+// checkboxes, block quotes, links and horizontal rules.
+func RenderMarkdown(text string, width int) string {
+	if width < 10 {
+		width = 10
+	}
+	r := mdRenderer{width: width}
+	r.render(strings.ReplaceAll(text, "\r\n", "\n"))
+	return strings.Join(r.out, "\n")
+}
+
+type mdRenderer struct {
+	width   int
+	out     []string
+	pending *mdBlock
+
+	inCode    bool
+	fence     string
+	codeLang  string
+	codeLines []string
+}
+
+func (r *mdRenderer) render(text string) {
+	for _, line := range strings.Split(text, "\n") {
+		if r.inCode {
+			if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, r.fence) && strings.Trim(trimmed, r.fence[:1]) == "" {
+				r.flushCode()
+			} else {
+				r.codeLines = append(r.codeLines, line)
+			}
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			r.flush()
+			r.blank()
+
+		case mdFenceRe.MatchString(line):
+			r.flush()
+			match := mdFenceRe.FindStringSubmatch(line)
+			r.inCode = true
+			r.fence = match[2]
+			r.codeLang = match[3]
+			r.codeLines = nil
+
+		case mdHeadingRe.MatchString(trimmed):
+			r.flush()
+			match := mdHeadingRe.FindStringSubmatch(trimmed)
+			style := MarkdownHeadingStyle
+			switch len(match[1]) {
+			case 1:
+				style = MarkdownH1Style
+			case 2:
+				style = MarkdownH2Style
+			}
+			for _, l := range wrapLines(renderInline(match[2]), r.width) {
+				r.out = append(r.out, style.Render(l))
+			}
+
+		case mdRuleRe.MatchString(trimmed):
+			r.flush()
+			r.out = append(r.out, MarkdownMutedStyle.Render(strings.Repeat(Glyphs.Rule, r.width)))
+
+		case strings.HasPrefix(trimmed, ">"):
+			content := strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))
+			if r.pending == nil || r.pending.kind != mdQuote {
+				r.flush()
+				style := MarkdownQuoteStyle
+				r.pending = &mdBlock{kind: mdQuote, first: Glyphs.Quote, rest: Glyphs.Quote, style: &style}
+			}
+			if content == "" {
+				// An empty quote line separates paragraphs inside the quote
+				r.pending.lines = append(r.pending.lines, "\n")
+			} else {
+				r.pending.lines = append(r.pending.lines, content)
+			}
+
+		case mdListRe.MatchString(line):
+			r.flush()
+			match := mdListRe.FindStringSubmatch(line)
+			indent := strings.Repeat("  ", len(strings.ReplaceAll(match[1], "\t", "    "))/2)
+			marker := match[2]
+			content := match[3]
+			if !strings.ContainsAny(marker[:1], "0123456789") {
+				marker = Glyphs.Bullet
+			}
+			if box := mdCheckboxRe.FindStringSubmatch(content); box != nil {
+				if box[1] == " " {
+					marker = Glyphs.Unchecked
+				} else {
+					marker = MarkdownCheckedStyle.Render(Glyphs.Checked)
+				}
+				content = box[2]
+			}
+			first := indent + marker + " "
+			r.pending = &mdBlock{
+				kind:  mdListItem,
+				first: first,
+				rest:  strings.Repeat(" ", lipgloss.Width(first)),
+				lines: []string{content},
+			}
+
+		default:
+			if r.pending != nil && r.pending.kind == mdListItem && line != trimmed {
+				// Indented line continues the list item
+				r.pending.lines = append(r.pending.lines, trimmed)
+				continue
+			}
+			if r.pending == nil || r.pending.kind != mdParagraph {
+				r.flush()
+				r.pending = &mdBlock{kind: mdParagraph}
+			}
+			r.pending.lines = append(r.pending.lines, trimmed)
+		}
+	}
+
+	if r.inCode {
+		r.flushCode()
+	}
+	r.flush()
+
+	// Drop trailing blank lines
+	for len(r.out) > 0 && r.out[len(r.out)-1] == "" {
+		r.out = r.out[:len(r.out)-1]
+	}
+}
+
+// blank adds a single separating empty line
+func (r *mdRenderer) blank() {
+	if len(r.out) > 0 && r.out[len(r.out)-1] != "" {
+		r.out = append(r.out, "")
+	}
+}
+
+// flush renders the pending paragraph, quote or list item
+func (r *mdRenderer) flush() {
+	block := r.pending
+	r.pending = nil
+	if block == nil || len(block.lines) == 0 {
+		return
+	}
+
+	inner := r.width - lipgloss.Width(block.first)
+	if inner < 5 {
+		inner = 5
+	}
+
+	// Quotes may hold several paragraphs separated by "\n" markers
+	var paragraphs [][]string
+	current := []string{}
+	for _, l := range block.lines {
+		if l == "\n" {
+			paragraphs = append(paragraphs, current)
+			current = []string{}
+			continue
+		}
+		current = append(current, l)
+	}
+	paragraphs = append(paragraphs, current)
+
+	firstLine := true
+	for i, para := range paragraphs {
+		if i > 0 {
+			r.out = append(r.out, MarkdownMutedStyle.Render(strings.TrimRight(block.rest, " ")))
+		}
+		if len(para) == 0 {
+			continue
+		}
+		for _, l := range wrapLines(renderInline(strings.Join(para, " ")), inner) {
+			if block.style != nil {
+				l = block.style.Render(l)
+			}
+			prefix := block.rest
+			if firstLine {
+				prefix = block.first
+				firstLine = false
+			}
+			if block.style != nil {
+				prefix = MarkdownMutedStyle.Render(prefix)
+			}
+			r.out = append(r.out, prefix+l)
+		}
+	}
+}
+
+// flushCode renders a fenced code block without wrapping
+func (r *mdRenderer) flushCode() {
+	r.inCode = false
+	if r.codeLang != "" {
+		r.out = append(r.out, MarkdownMutedStyle.Render("  "+r.codeLang))
+	}
+	for _, l := range r.codeLines {
+		l = strings.ReplaceAll(l, "\t", "    ")
+		r.out = append(r.out, "  "+MarkdownCodeBlockStyle.MaxWidth(r.width-2).Render(l))
+	}
+	r.codeLines = nil
+	r.codeLang = ""
+}
+
+// wrapLines word-wraps styled text to width without padding the lines
+func wrapLines(s string, width int) []string {
+	wrapped := lipgloss.NewStyle().Width(width).Render(s)
+	lines := strings.Split(wrapped, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " ")
+	}
+	return lines
+}
+
+// renderInline styles emphasis, inline code and links within a line
+func renderInline(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && strings.IndexByte("\\`*_{}[]()#+-.!~>|", s[i+1]) >= 0:
+			b.WriteByte(s[i+1])
+			i += 2
+			continue
+
+		case c == '`':
+			ticks := countRun(s[i:], '`')
+			fence := strings.Repeat("`", ticks)
+			if end := strings.Index(s[i+ticks:], fence); end >= 0 {
+				code := strings.TrimSpace(s[i+ticks : i+ticks+end])
+				b.WriteString(MarkdownCodeStyle.Render(code))
+				i += ticks + end + ticks
+				continue
+			}
+
+		case c == '[':
+			if text, url, n, ok := parseLink(s[i:]); ok {
+				b.WriteString(MarkdownLinkStyle.Render(renderInline(text)))
+				if url != "" && url != text {
+					b.WriteString(MarkdownMutedStyle.Render(" (" + url + ")"))
+				}
+				i += n
+				continue
+			}
+
+		case c == '<':
+			if end := strings.IndexByte(s[i:], '>'); end > 0 {
+				inner := s[i+1 : i+end]
+				if strings.HasPrefix(inner, "http://") || strings.HasPrefix(inner, "https://") || strings.HasPrefix(inner, "mailto:") {
+					b.WriteString(MarkdownLinkStyle.Render(inner))
+					i += end + 1
+					continue
+				}
+			}
+
+		case c == '~' && strings.HasPrefix(s[i:], "~~"):
+			if end := strings.Index(s[i+2:], "~~"); end > 0 {
+				b.WriteString(MarkdownStrikeStyle.Render(renderInline(s[i+2 : i+2+end])))
+				i += end + 4
+				continue
+			}
+
+		case c == '*' || c == '_':
+			if n, inner, ok := parseEmphasis(s, i); ok {
+				style := MarkdownItalicStyle
+				if countRun(s[i:], c) >= 2 {
+					style = MarkdownBoldStyle
+				}
+				b.WriteString(style.Render(renderInline(inner)))
+				i += n
+				continue
+			}
+		}
+
+		b.WriteByte(c)
+		i++
+	}
+	return b.String()
+}
+
+// parseEmphasis matches *text*, **text**, _text_ or __text__ starting at i.
+// Underscores inside words (snake_case) are left alone.
+func parseEmphasis(s string, i int) (int, string, bool) {
+	c := s[i]
+	n := countRun(s[i:], c)
+	if n > 2 {
+		n = 2
+	}
+	if c == '_' && i > 0 && isWordByte(s[i-1]) {
+		return 0, "", false
+	}
+	start := i + n
+	if start >= len(s) || s[start] == ' ' {
+		return 0, "", false
+	}
+	marker := strings.Repeat(string(c), n)
+	for j := start + 1; j <= len(s)-n; j++ {
+		if s[j:j+n] != marker || s[j-1] == ' ' {
+			continue
+		}
+		if n == 1 && j+1 < len(s) && s[j+1] == c {
+			// Part of a longer run, e.g. the closing of a bold span
+			j++
+			continue
+		}
+		if c == '_' && j+n < len(s) && isWordByte(s[j+n]) {
+			continue
+		}
+		return j + n - i, s[start:j], true
+	}
+	return 0, "", false
+}
+
+// parseLink matches [text](url) at the start of s
+func parseLink(s string) (text, url string, n int, ok bool) {
+	closeText := strings.Index(s, "](")
+	if closeText < 1 {
+		return "", "", 0, false
+	}
+	closeURL := strings.IndexByte(s[closeText+2:], ')')
+	if closeURL < 0 {
+		return "", "", 0, false
+	}
+	text = s[1:closeText]
+	if strings.ContainsAny(text, "[]") {
+		return "", "", 0, false
+	}
+	url = strings.TrimSpace(s[closeText+2 : closeText+2+closeURL])
+	return text, url, closeText + 2 + closeURL + 1, true
+}
+
+func countRun(s string, c byte) int {
+	n := 0
+	for n < len(s) && s[n] == c {
+		n++
+	}
+	return n
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestRenderMarkdownBlocks(t *testing.T) {
+	input := strings.Join([]string{
+		"# Title",
+		"",
+		"Some **bold** and *italic* text with `code`",
+		"continued on the next line.",
+		"",
+		"- first item",
+		"  - nested item",
+		"1. numbered",
+		"- [ ] todo",
+		"- [x] done",
+		"",
+		"> quoted",
+		"",
+		"```go",
+		"func main() {}",
+		"```",
+		"",
+		"---",
+		"See [the docs](https://example.com) and my_var_name.",
+	}, "\n")
+
+	got := RenderMarkdown(input, 60)
+	want := []string{
+		"Title",
+		"",
+		"Some bold and italic text with code continued on the next",
+		"line.",
+		"",
+		"• first item",
+		"  • nested item",
+		"1. numbered",
+		"☐ todo",
+		"☑ done",
+		"",
+		"│ quoted",
+		"",
+		"  go",
+		"  func main() {}",
+		"",
+		strings.Repeat("─", 60),
+		"See the docs (https://example.com) and my_var_name.",
+	}
+	if got != strings.Join(want, "\n") {
+		t.Errorf("unexpected rendering:\n%s\n--- want ---\n%s", got, strings.Join(want, "\n"))
+	}
+}
+
+func TestRenderMarkdownWrapsToWidth(t *testing.T) {
+	input := "- " + strings.Repeat("word ", 20)
+	for i, line := range strings.Split(RenderMarkdown(input, 30), "\n") {
+		if w := lipgloss.Width(line); w > 30 {
+			t.Errorf("line %d is %d wide, want at most 30: %q", i, w, line)
+		}
+		if i > 0 && !strings.HasPrefix(line, "  ") {
+			t.Errorf("continuation line %d should hang under the bullet: %q", i, line)
+		}
+	}
+}
+
+func TestRenderInlineEscapes(t *testing.T) {
+	tests := map[string]string{
+		`\*not italic\*`:        "*not italic*",
+		"snake_case_name":       "snake_case_name",
+		"2 * 3 * 4":             "2 * 3 * 4",
+		"``code with ` tick``":  "code with ` tick",
+		"~~gone~~ here":         "gone here",
+		"<https://example.com>": "https://example.com",
+	}
+	for input, want := range tests {
+		if got := renderInline(input); got != want {
+			t.Errorf("renderInline(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"math"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -27,6 +28,7 @@ type Modal struct {
 	Type     ModalType
 	Title    string
 	Subtitle string // e.g., issue ID
+	Body     string // Optional text shown above the input or options
 
 	// For input modals
 	Input textinput.Model
@@ -116,6 +118,48 @@ func (m Modal) InputValue() string {
 
 // View renders the modal centered in the given dimensions
 func (m Modal) View(width, height int) string {
+	modalBox, _ := m.box(width)
+
+	// Center the modal in the available space
+	return lipgloss.Place(
+		width, height,
+		lipgloss.Center, lipgloss.Center,
+		modalBox,
+	)
+}
+
+// OptionAt returns the select option drawn at x, y when the modal is
+// centered in the given dimensions, or -1
+func (m Modal) OptionAt(width, height, x, y int) int {
+	if m.Type != ModalSelect {
+		return -1
+	}
+	modalBox, firstOption := m.box(width)
+
+	// Same arithmetic as lipgloss.Place with centered positions
+	boxWidth, boxHeight := lipgloss.Width(modalBox), lipgloss.Height(modalBox)
+	left := (width - boxWidth) - int(math.Round(float64(width-boxWidth)*0.5))
+	top := (height - boxHeight) - int(math.Round(float64(height-boxHeight)*0.5))
+	if width < boxWidth {
+		left = 0
+	}
+	if height < boxHeight {
+		top = 0
+	}
+	if x < left || x >= left+boxWidth {
+		return -1
+	}
+
+	// Options start below the border and the top padding
+	i := y - top - 2 - firstOption
+	if i < 0 || i >= len(m.Options) {
+		return -1
+	}
+	return i
+}
+
+// box renders the modal box and returns the content line of its first option
+func (m Modal) box(width int) (string, int) {
 	var content strings.Builder
 
 	// Modal width - fixed reasonable size
@@ -123,6 +167,7 @@ func (m Modal) View(width, height int) string {
 	if modalWidth > width-4 {
 		modalWidth = width - 4
 	}
+	firstOption := 0
 
 	// Build modal content
 	titleStyle := lipgloss.NewStyle().
@@ -141,6 +186,13 @@ func (m Modal) View(width, height int) string {
 	content.WriteString(titleLine)
 	content.WriteString("\n\n")
 
+	if m.Body != "" {
+		bodyStyle := lipgloss.NewStyle().
+			Foreground(ColorWhite)
+		content.WriteString(bodyStyle.Render(m.Body))
+		content.WriteString("\n\n")
+	}
+
 	if m.Type == ModalInput {
 		// Text input - no extra border, modal border is enough
 		content.WriteString(m.Input.View())
@@ -150,6 +202,9 @@ func (m Modal) View(width, height int) string {
 		helpStyle := lipgloss.NewStyle().Foreground(ColorMuted)
 		content.WriteString(helpStyle.Render("enter: save  esc: cancel"))
 	} else {
+		// Lines above the options, wrapped like the modal content
+		firstOption = lipgloss.Height(lipgloss.NewStyle().Width(modalWidth-4).Render(content.String()+" ")) - 1
+
 		// Vertical select options
 		for i, opt := range m.Options {
 			var optText string
@@ -180,17 +235,10 @@ func (m Modal) View(width, height int) string {
 
 	// Style the modal box
 	modalStyle := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(Glyphs.Border).
 		BorderForeground(ColorPrimary).
 		Padding(1, 2).
 		Width(modalWidth)
 
-	modalBox := modalStyle.Render(content.String())
-
-	// Center the modal in the available space
-	return lipgloss.Place(
-		width, height,
-		lipgloss.Center, lipgloss.Center,
-		modalBox,
-	)
+	return modalStyle.Render(content.String()), firstOption
 }
@@ -2,151 +2,211 @@ package ui
 
 import "github.com/charmbracelet/lipgloss"
 
-// Colors - lazygit-inspired theme
+// Colors of the active theme, set by ApplyTheme
 var (
-	ColorPrimary   = lipgloss.Color("2") // Green (selected/active)
-	ColorSecondary = lipgloss.Color("4") // Blue (options/help keys)
-	ColorAccent    = lipgloss.Color("6") // Cyan (search/accent)
-	ColorWarning   = lipgloss.Color("3") // Yellow
-	ColorDanger    = lipgloss.Color("1") // Red
-	ColorMuted     = lipgloss.Color("8") // Bright black (gray)
-	ColorWhite     = lipgloss.Color("7") // White
-	ColorMagenta   = lipgloss.Color("5") // Magenta
-	ColorBorder    = lipgloss.Color("8") // Gray border
+	ColorPrimary   lipgloss.Color // selected/active
+	ColorSecondary lipgloss.Color // options/help keys
+	ColorAccent    lipgloss.Color // search/accent
+	ColorWarning   lipgloss.Color
+	ColorDanger    lipgloss.Color
+	ColorMuted     lipgloss.Color
+	ColorWhite     lipgloss.Color // regular text
+	ColorMagenta   lipgloss.Color
+	ColorBorder    lipgloss.Color
+
+	// Highlighted rows and the inline edit bar
+	ColorSelection     lipgloss.Color
+	ColorSelectionText lipgloss.Color
+	ColorBar           lipgloss.Color
+	ColorBarText       lipgloss.Color
+
+	// Rows of deferred and blocked issues
+	ColorSlate   lipgloss.Color
+	ColorSlateBg lipgloss.Color
+	ColorAmber   lipgloss.Color
+	ColorAmberBg lipgloss.Color
 )
 
 // Priority colors
-var PriorityColors = map[int]lipgloss.Color{
-	0: ColorDanger,    // P0 - Critical (red)
-	1: ColorWarning,   // P1 - High (yellow)
-	2: ColorSecondary, // P2 - Medium (blue)
-	3: ColorMuted,     // P3 - Low (gray)
-	4: ColorMuted,     // P4 - Backlog (gray)
-}
+var PriorityColors map[int]lipgloss.Color
 
 // Status colors
-var StatusColors = map[string]lipgloss.Color{
-	"open":        ColorPrimary, // Green
-	"in_progress": ColorWarning, // Yellow
-	"closed":      ColorMuted,   // Gray
-}
+var StatusColors map[string]lipgloss.Color
+
+// Issue type colors
+var TypeColors map[string]lipgloss.Color
 
-// Base styles
+// Base styles, built from the theme colors by ApplyTheme
 var (
+	AppStyle               lipgloss.Style
+	TitleStyle             lipgloss.Style
+	PanelStyle             lipgloss.Style
+	FocusedPanelStyle      lipgloss.Style
+	PanelTitleStyle        lipgloss.Style
+	TaskItemStyle          lipgloss.Style
+	SelectedTaskStyle      lipgloss.Style
+	TaskIDStyle            lipgloss.Style
+	TaskTitleStyle         lipgloss.Style
+	StatusBarStyle         lipgloss.Style
+	HelpBarStyle           lipgloss.Style
+	HelpKeyStyle           lipgloss.Style
+	HelpDescStyle          lipgloss.Style
+	DetailLabelStyle       lipgloss.Style
+	DetailValueStyle       lipgloss.Style
+	DetailTabStyle         lipgloss.Style
+	ActiveDetailTabStyle   lipgloss.Style
+	IssueLinkStyle         lipgloss.Style
+	SelectedIssueLinkStyle lipgloss.Style
+	FormLabelStyle         lipgloss.Style
+	FormInputStyle         lipgloss.Style
+	FormInputFocusedStyle  lipgloss.Style
+	FormButtonStyle        lipgloss.Style
+	FormButtonFocusedStyle lipgloss.Style
+	OverlayStyle           lipgloss.Style
+	ErrorStyle             lipgloss.Style
+	SuccessStyle           lipgloss.Style
+	WarningStyle           lipgloss.Style
+)
+
+// buildStyles creates the base styles from the current colors
+func buildStyles() {
 	// App container
 	AppStyle = lipgloss.NewStyle().
-			Padding(0, 1)
+		Padding(0, 1)
 
 	// Title bar
 	TitleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(ColorPrimary).
-			Padding(0, 1)
+		Bold(true).
+		Foreground(ColorPrimary).
+		Padding(0, 1)
 
 	// Panel styles
 	PanelStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(ColorBorder).
-			Padding(0, 1)
+		Border(Glyphs.Border).
+		BorderForeground(ColorBorder).
+		Padding(0, 1)
 
 	FocusedPanelStyle = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(ColorPrimary).
-				Bold(true).
-				Padding(0, 1)
+		Border(Glyphs.Border).
+		BorderForeground(ColorPrimary).
+		Bold(true).
+		Padding(0, 1)
 
 	PanelTitleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(ColorWhite).
-			MarginBottom(1)
+		Bold(true).
+		Foreground(ColorWhite).
+		MarginBottom(1)
 
 	// Task list item styles
 	TaskItemStyle = lipgloss.NewStyle().
-			PaddingLeft(2)
+		PaddingLeft(2)
 
 	SelectedTaskStyle = lipgloss.NewStyle().
-				PaddingLeft(1).
-				Foreground(ColorAccent).
-				Bold(true)
+		PaddingLeft(1).
+		Foreground(ColorAccent).
+		Bold(true)
 
 	TaskIDStyle = lipgloss.NewStyle().
-			Foreground(ColorMuted).
-			Width(12)
+		Foreground(ColorMuted).
+		Width(12)
 
 	TaskTitleStyle = lipgloss.NewStyle().
-			Foreground(ColorWhite)
+		Foreground(ColorWhite)
 
 	// Status bar
 	StatusBarStyle = lipgloss.NewStyle().
-			Foreground(ColorMuted).
-			Padding(0, 1).
-			MarginTop(1)
+		Foreground(ColorMuted).
+		Padding(0, 1).
+		MarginTop(1)
 
 	// Help bar at bottom
 	HelpBarStyle = lipgloss.NewStyle().
-			Foreground(ColorMuted).
-			Padding(0, 1)
+		Foreground(ColorMuted).
+		Padding(0, 1)
 
 	HelpKeyStyle = lipgloss.NewStyle().
-			Foreground(ColorSecondary).
-			Bold(true)
+		Foreground(ColorSecondary).
+		Bold(true)
 
 	HelpDescStyle = lipgloss.NewStyle().
-			Foreground(ColorWhite)
+		Foreground(ColorWhite)
 
 	// Detail view
 	DetailLabelStyle = lipgloss.NewStyle().
-				Foreground(ColorSecondary).
-				Bold(true).
-				Width(12)
+		Foreground(ColorSecondary).
+		Bold(true).
+		Width(12)
 
 	DetailValueStyle = lipgloss.NewStyle().
-				Foreground(ColorWhite)
+		Foreground(ColorWhite)
+
+	// Detail pane tabs
+	DetailTabStyle = lipgloss.NewStyle().
+		Foreground(ColorMuted)
+
+	ActiveDetailTabStyle = lipgloss.NewStyle().
+		Foreground(ColorPrimary).
+		Bold(true).
+		Underline(true)
+
+	// Issue IDs in the detail view that can be followed
+	IssueLinkStyle = lipgloss.NewStyle().
+		Foreground(ColorPrimary).
+		Underline(true)
+
+	SelectedIssueLinkStyle = lipgloss.NewStyle().
+		Foreground(ColorWhite).
+		Background(ColorPrimary).
+		Bold(true)
 
 	// Form styles
 	FormLabelStyle = lipgloss.NewStyle().
-			Foreground(ColorSecondary).
-			Bold(true).
-			MarginRight(1)
+		Foreground(ColorSecondary).
+		Bold(true).
+		MarginRight(1)
 
 	FormInputStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(ColorBorder).
-			Padding(0, 1)
+		Border(Glyphs.Border).
+		BorderForeground(ColorBorder).
+		Padding(0, 1)
 
 	FormInputFocusedStyle = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(ColorPrimary).
-				Padding(0, 1)
+		Border(Glyphs.Border).
+		BorderForeground(ColorPrimary).
+		Padding(0, 1)
 
 	FormButtonStyle = lipgloss.NewStyle().
-			Border(lipgloss.NormalBorder()).
-			BorderForeground(ColorBorder).
-			Foreground(ColorWhite).
-			Padding(0, 2)
+		Border(Glyphs.ButtonBorder).
+		BorderForeground(ColorBorder).
+		Foreground(ColorWhite).
+		Padding(0, 2)
 
 	FormButtonFocusedStyle = lipgloss.NewStyle().
-				Border(lipgloss.NormalBorder()).
-				BorderForeground(ColorPrimary).
-				Background(ColorPrimary).
-				Foreground(ColorWhite).
-				Bold(true).
-				Padding(0, 2)
+		Border(Glyphs.ButtonBorder).
+		BorderForeground(ColorPrimary).
+		Background(ColorPrimary).
+		Foreground(ColorWhite).
+		Bold(true).
+		Padding(0, 2)
 
 	// Overlay/modal
 	OverlayStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(ColorPrimary).
-			Padding(1, 2)
+		Border(Glyphs.Border).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2)
 
 	// Error/message styles
 	ErrorStyle = lipgloss.NewStyle().
-			Foreground(ColorDanger).
-			Bold(true)
+		Foreground(ColorDanger).
+		Bold(true)
 
 	SuccessStyle = lipgloss.NewStyle().
-			Foreground(ColorPrimary)
-)
+		Foreground(ColorPrimary)
+
+	WarningStyle = lipgloss.NewStyle().
+		Foreground(ColorWarning).
+		Bold(true)
+}
 
 // PriorityStyle returns a styled priority string
 func PriorityStyle(priority int) lipgloss.Style {
@@ -159,6 +219,15 @@ func PriorityStyle(priority int) lipgloss.Style {
 		Bold(priority <= 1) // Bold for P0/P1
 }
 
+// TypeStyle returns a styled issue type string
+func TypeStyle(issueType string) lipgloss.Style {
+	color, ok := TypeColors[issueType]
+	if !ok {
+		color = ColorWhite
+	}
+	return lipgloss.NewStyle().Foreground(color)
+}
+
 // StatusStyle returns a styled status string
 func StatusStyle(status string) lipgloss.Style {
 	color, ok := StatusColors[status]
@@ -0,0 +1,211 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Theme is a color palette. Status, priority and type colors default to the
+// semantic colors; the maps only hold overrides.
+type Theme struct {
+	Name string
+
+	Primary   lipgloss.Color
+	Secondary lipgloss.Color
+	Accent    lipgloss.Color
+	Warning   lipgloss.Color
+	Danger    lipgloss.Color
+	Muted     lipgloss.Color
+	Text      lipgloss.Color
+	Magenta   lipgloss.Color
+	Border    lipgloss.Color
+
+	Selection     lipgloss.Color
+	SelectionText lipgloss.Color
+	Bar           lipgloss.Color
+	BarText       lipgloss.Color
+
+	Slate   lipgloss.Color
+	SlateBg lipgloss.Color
+	Amber   lipgloss.Color
+	AmberBg lipgloss.Color
+
+	Status   map[string]lipgloss.Color
+	Priority map[int]lipgloss.Color
+	Type     map[string]lipgloss.Color
+}
+
+// Built-in theme names
+const (
+	ThemeDark         = "dark"
+	ThemeLight        = "light"
+	ThemeHighContrast = "high-contrast"
+)
+
+func init() {
+	ApplyTheme(DarkTheme())
+}
+
+// DarkTheme is the default lazygit-inspired palette for dark terminals
+func DarkTheme() Theme {
+	return Theme{
+		Name:          ThemeDark,
+		Primary:       lipgloss.Color("2"), // Green
+		Secondary:     lipgloss.Color("4"), // Blue
+		Accent:        lipgloss.Color("6"), // Cyan
+		Warning:       lipgloss.Color("3"), // Yellow
+		Danger:        lipgloss.Color("1"), // Red
+		Muted:         lipgloss.Color("8"), // Bright black (gray)
+		Text:          lipgloss.Color("7"), // White
+		Magenta:       lipgloss.Color("5"),
+		Border:        lipgloss.Color("8"),
+		Selection:     lipgloss.Color("#2a4a6d"),
+		SelectionText: lipgloss.Color("15"),
+		Bar:           lipgloss.Color("7"),
+		BarText:       lipgloss.Color("0"),
+		Slate:         lipgloss.Color("#9aa0a6"),
+		SlateBg:       lipgloss.Color("#3a3f45"),
+		Amber:         lipgloss.Color("#c9a400"),
+		AmberBg:       lipgloss.Color("#5a4a00"),
+	}
+}
+
+// LightTheme uses darker text and pale highlights for light terminals
+func LightTheme() Theme {
+	return Theme{
+		Name:          ThemeLight,
+		Primary:       lipgloss.Color("#1a7f37"),
+		Secondary:     lipgloss.Color("#0550ae"),
+		Accent:        lipgloss.Color("#0a7ea4"),
+		Warning:       lipgloss.Color("#9a6700"),
+		Danger:        lipgloss.Color("#cf222e"),
+		Muted:         lipgloss.Color("#6e7781"),
+		Text:          lipgloss.Color("#1f2328"),
+		Magenta:       lipgloss.Color("#8250df"),
+		Border:        lipgloss.Color("#afb8c1"),
+		Selection:     lipgloss.Color("#cfe2ff"),
+		SelectionText: lipgloss.Color("#1f2328"),
+		Bar:           lipgloss.Color("#d0d7de"),
+		BarText:       lipgloss.Color("#1f2328"),
+		Slate:         lipgloss.Color("#57606a"),
+		SlateBg:       lipgloss.Color("#e6e9ed"),
+		Amber:         lipgloss.Color("#9a6700"),
+		AmberBg:       lipgloss.Color("#fff1c2"),
+	}
+}
+
+// HighContrastTheme uses bright ANSI colors and solid highlights
+func HighContrastTheme() Theme {
+	return Theme{
+		Name:          ThemeHighContrast,
+		Primary:       lipgloss.Color("10"),
+		Secondary:     lipgloss.Color("12"),
+		Accent:        lipgloss.Color("14"),
+		Warning:       lipgloss.Color("11"),
+		Danger:        lipgloss.Color("9"),
+		Muted:         lipgloss.Color("7"),
+		Text:          lipgloss.Color("15"),
+		Magenta:       lipgloss.Color("13"),
+		Border:        lipgloss.Color("15"),
+		Selection:     lipgloss.Color("11"),
+		SelectionText: lipgloss.Color("0"),
+		Bar:           lipgloss.Color("15"),
+		BarText:       lipgloss.Color("0"),
+		Slate:         lipgloss.Color("15"),
+		SlateBg:       lipgloss.Color("8"),
+		Amber:         lipgloss.Color("11"),
+		AmberBg:       lipgloss.Color("3"),
+	}
+}
+
+// BuiltinTheme returns a built-in theme by name
+func BuiltinTheme(name string) (Theme, bool) {
+	switch name {
+	case ThemeDark:
+		return DarkTheme(), true
+	case ThemeLight:
+		return LightTheme(), true
+	case ThemeHighContrast:
+		return HighContrastTheme(), true
+	}
+	return Theme{}, false
+}
+
+// BuiltinThemeNames lists the built-in themes
+func BuiltinThemeNames() []string {
+	return []string{ThemeDark, ThemeLight, ThemeHighContrast}
+}
+
+// ParseColor accepts ANSI color numbers (0-255) and #rgb/#rrggbb hex colors
+func ParseColor(s string) (lipgloss.Color, error) {
+	if len(s) > 0 && s[0] == '#' {
+		if len(s) != 4 && len(s) != 7 {
+			return "", fmt.Errorf("invalid color %q", s)
+		}
+		for _, c := range s[1:] {
+			if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+				return "", fmt.Errorf("invalid color %q", s)
+			}
+		}
+		return lipgloss.Color(s), nil
+	}
+	var n int
+	if _, err := fmt.Sscanf(s, "%d", &n); err != nil || n < 0 || n > 255 || fmt.Sprint(n) != s {
+		return "", fmt.Errorf("invalid color %q: use 0-255 or #rrggbb", s)
+	}
+	return lipgloss.Color(s), nil
+}
+
+// ApplyTheme sets the colors and rebuilds every style from them
+func ApplyTheme(t Theme) {
+	ColorPrimary = t.Primary
+	ColorSecondary = t.Secondary
+	ColorAccent = t.Accent
+	ColorWarning = t.Warning
+	ColorDanger = t.Danger
+	ColorMuted = t.Muted
+	ColorWhite = t.Text
+	ColorMagenta = t.Magenta
+	ColorBorder = t.Border
+	ColorSelection = t.Selection
+	ColorSelectionText = t.SelectionText
+	ColorBar = t.Bar
+	ColorBarText = t.BarText
+	ColorSlate = t.Slate
+	ColorSlateBg = t.SlateBg
+	ColorAmber = t.Amber
+	ColorAmberBg = t.AmberBg
+
+	PriorityColors = map[int]lipgloss.Color{
+		0: ColorDanger,    // P0 - Critical
+		1: ColorWarning,   // P1 - High
+		2: ColorSecondary, // P2 - Medium
+		3: ColorMuted,     // P3 - Low
+		4: ColorMuted,     // P4 - Backlog
+	}
+	StatusColors = map[string]lipgloss.Color{
+		"open":        ColorPrimary,
+		"in_progress": ColorWarning,
+		"closed":      ColorMuted,
+	}
+	TypeColors = map[string]lipgloss.Color{
+		"bug":     ColorDanger,
+		"feature": ColorPrimary,
+		"task":    ColorSecondary,
+		"epic":    ColorMagenta,
+		"chore":   ColorMuted,
+	}
+	for priority, color := range t.Priority {
+		PriorityColors[priority] = color
+	}
+	for status, color := range t.Status {
+		StatusColors[status] = color
+	}
+	for issueType, color := range t.Type {
+		TypeColors[issueType] = color
+	}
+
+	buildStyles()
+	buildMarkdownStyles()
+}
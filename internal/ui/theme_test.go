@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestParseColor(t *testing.T) {
+	for _, valid := range []string{"0", "15", "255", "#fff", "#2a4a6d"} {
+		if _, err := ParseColor(valid); err != nil {
+			t.Errorf("expected %q to be valid, got %v", valid, err)
+		}
+	}
+	for _, invalid := range []string{"", "256", "-1", "07", "red", "#12345", "#ggg"} {
+		if _, err := ParseColor(invalid); err == nil {
+			t.Errorf("expected %q to be rejected", invalid)
+		}
+	}
+}
+
+func TestApplyThemeOverrides(t *testing.T) {
+	defer ApplyTheme(DarkTheme())
+
+	theme := LightTheme()
+	theme.Status = map[string]lipgloss.Color{"closed": "#123456"}
+	theme.Type = map[string]lipgloss.Color{"spike": "13"}
+	ApplyTheme(theme)
+
+	if StatusColors["closed"] != "#123456" || StatusColors["open"] != theme.Primary {
+		t.Errorf("expected closed override with other statuses from the palette, got %v", StatusColors)
+	}
+	if TypeColors["spike"] != "13" || TypeColors["bug"] != theme.Danger {
+		t.Errorf("expected spike type color alongside defaults, got %v", TypeColors)
+	}
+	if ColorWhite != theme.Text {
+		t.Errorf("expected text color %q, got %q", theme.Text, ColorWhite)
+	}
+}
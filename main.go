@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -12,13 +13,19 @@ import (
 	"lazybeads/internal/app"
 	"lazybeads/internal/beads"
 	"lazybeads/internal/config"
+	"lazybeads/internal/ui"
 )
 
 func main() {
 	checkMode := flag.Bool("check", false, "Run headless validation (test bd CLI integration)")
 	configMode := flag.Bool("config", false, "Show config loading status and diagnostics")
+	asciiMode := flag.Bool("ascii", false, "Draw with ASCII-only markers, borders and tree prefixes")
+	noColor := flag.Bool("no-color", false, "Disable colors and show selection and state as text")
 	flag.Parse()
 
+	// Flags add to NO_COLOR and TERM=dumb; config.yml can enable more
+	ui.SetDisplay(ui.DisplayFromEnv(os.Getenv).Merge(ui.Display{ASCII: *asciiMode, NoColor: *noColor}))
+
 	// Config diagnostics mode (runs before beads check)
 	if *configMode {
 		showConfigStatus()
@@ -227,4 +234,71 @@ func showConfigStatus() {
 		fmt.Println("Custom Commands (0 loaded)")
 		fmt.Println("  (none)")
 	}
+
+	if cfg == nil {
+		return
+	}
+
+	// Show saved views offered by the command palette
+	if len(cfg.Views) > 0 {
+		fmt.Println()
+		fmt.Printf("Saved Views (%d)\n", len(cfg.Views))
+		for _, view := range cfg.Views {
+			fmt.Printf("  %s  %q\n", view.Name, view.Filter)
+		}
+	}
+
+	// Show the theme in use
+	fmt.Println()
+	theme, themeErr := app.ResolveTheme(cfg.Theme)
+	switch cfg.Theme.Name {
+	case "", "auto":
+		fmt.Printf("Theme: %s (auto, from terminal background)\n", theme.Name)
+	default:
+		fmt.Printf("Theme: %s\n", theme.Name)
+	}
+	if themeErr != nil {
+		fmt.Printf("  error: %v\n", themeErr)
+	}
+
+	// Show keybinding overrides and conflicts
+	fmt.Println()
+	overrides := 0
+	for _, context := range []struct {
+		name     string
+		bindings map[string]config.KeyList
+	}{
+		{"global", cfg.Keybindings.Global},
+		{"list", cfg.Keybindings.List},
+		{"detail", cfg.Keybindings.Detail},
+	} {
+		actions := make([]string, 0, len(context.bindings))
+		for action := range context.bindings {
+			actions = append(actions, action)
+		}
+		sort.Strings(actions)
+		for _, action := range actions {
+			if overrides == 0 {
+				fmt.Println("Keybindings")
+			}
+			overrides++
+			keys := strings.Join(context.bindings[action], ", ")
+			if keys == "" {
+				keys = "(unbound)"
+			}
+			fmt.Printf("  %-6s  %-16s %s\n", context.name, action, keys)
+		}
+	}
+	if overrides == 0 {
+		fmt.Println("Keybindings (defaults)")
+	}
+
+	problems := app.KeybindingProblems(cfg)
+	if len(problems) > 0 {
+		fmt.Println()
+		fmt.Printf("Keybinding Problems (%d)\n", len(problems))
+		for _, problem := range problems {
+			fmt.Printf("  %s\n", problem)
+		}
+	}
 }